@@ -1,14 +1,22 @@
 package main
 
 import (
+	"context"
 	"github.com/alpha-omega-corp/github-svc/pkg/server"
+	"github.com/alpha-omega-corp/github-svc/pkg/services/docker"
+	"github.com/alpha-omega-corp/github-svc/pkg/services/github"
+	"github.com/alpha-omega-corp/github-svc/pkg/services/job"
 	protoDocker "github.com/alpha-omega-corp/github-svc/proto/docker"
 	protoGithub "github.com/alpha-omega-corp/github-svc/proto/github"
+	protoJob "github.com/alpha-omega-corp/github-svc/proto/job"
 	"github.com/alpha-omega-corp/services/config"
 	svc "github.com/alpha-omega-corp/services/server"
 	_ "github.com/spf13/viper/remote"
 	"github.com/uptrace/bun"
 	"google.golang.org/grpc"
+	"os"
+	"os/signal"
+	"syscall"
 )
 
 func main() {
@@ -17,9 +25,38 @@ func main() {
 		panic(err)
 	}
 
+	engines := docker.NewEndpointHandler(env.Config)
+	defer func(engines docker.EndpointHandler) {
+		if err := engines.Close(); err != nil {
+			panic(err)
+		}
+	}(engines)
+
+	// the jobs are persisted with the etcd client of the github handler
+	git := github.NewHandler(env.Config)
+	jobs, err := job.NewHandler(git.Exec().Client())
+	if err != nil {
+		panic(err)
+	}
+	defer func(jobs job.Handler) {
+		if err := jobs.Close(); err != nil {
+			panic(err)
+		}
+	}(jobs)
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
 	if err := svc.NewGRPC(env.Host.Url, nil, func(_ *bun.DB, grpc *grpc.Server) {
-		protoGithub.RegisterGithubServiceServer(grpc, server.NewGithubServer(env))
-		protoDocker.RegisterDockerServiceServer(grpc, server.NewDockerServer(env))
+		protoGithub.RegisterGithubServiceServer(grpc, server.NewGithubServer(git, engines, jobs))
+		protoDocker.RegisterDockerServiceServer(grpc, server.NewDockerServer(env, engines, jobs))
+		protoJob.RegisterJobServiceServer(grpc, server.NewJobServer(jobs))
+
+		// stopping the server returns from NewGRPC, which then closes the docker engines
+		go func() {
+			<-ctx.Done()
+			grpc.GracefulStop()
+		}()
 	}); err != nil {
 		panic(err)
 	}
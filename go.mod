@@ -4,7 +4,9 @@ go 1.21
 
 require (
 	github.com/alpha-omega-corp/services v0.0.0-20240110111926-6b5fe3d84979
+	github.com/docker/distribution v2.8.2+incompatible
 	github.com/docker/docker v24.0.7+incompatible
+	github.com/docker/go-connections v0.4.0
 	github.com/google/go-github/v56 v56.0.0
 	github.com/spf13/viper v1.18.2
 	github.com/uptrace/bun v1.1.16
@@ -27,8 +29,6 @@ require (
 	github.com/armon/go-metrics v0.4.1 // indirect
 	github.com/coreos/go-semver v0.3.0 // indirect
 	github.com/coreos/go-systemd/v22 v22.3.2 // indirect
-	github.com/docker/distribution v2.8.2+incompatible // indirect
-	github.com/docker/go-connections v0.4.0 // indirect
 	github.com/docker/go-units v0.5.0 // indirect
 	github.com/fatih/color v1.15.0 // indirect
 	github.com/fsnotify/fsnotify v1.7.0 // indirect
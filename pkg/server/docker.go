@@ -2,30 +2,61 @@ package server
 
 import (
 	"context"
+	"encoding/base64"
+	"errors"
 	"github.com/alpha-omega-corp/github-svc/pkg/services/docker"
+	"github.com/alpha-omega-corp/github-svc/pkg/services/docker/handlers"
+	gitHandlers "github.com/alpha-omega-corp/github-svc/pkg/services/github/handlers"
+	"github.com/alpha-omega-corp/github-svc/pkg/services/job"
+	pkgTypes "github.com/alpha-omega-corp/github-svc/pkg/types"
 	proto "github.com/alpha-omega-corp/github-svc/proto/docker"
 	"github.com/alpha-omega-corp/services/types"
+	dockerTypes "github.com/docker/docker/api/types"
+	"github.com/docker/docker/api/types/container"
+	"github.com/docker/docker/api/types/filters"
+	"github.com/docker/docker/api/types/network"
+	"github.com/google/go-github/v56/github"
 	"io"
 	"net/http"
+	"sort"
+	"strconv"
 	"strings"
+	"time"
 )
 
 var repository = "container-images"
 
+const defaultWaitTimeout = time.Minute
+
 type DockerServer struct {
 	proto.UnimplementedDockerServiceServer
 
-	handler docker.Handler
+	endpoints   docker.EndpointHandler
+	repoHandler gitHandlers.RepositoryHandler
+	tmplHandler gitHandlers.TemplateHandler
+	pkgHandler  gitHandlers.PackageHandler
+	jobs        job.Handler
 }
 
-func NewDockerServer(env *types.Environment) *DockerServer {
+func NewDockerServer(env *types.Environment, engines docker.EndpointHandler, jobs job.Handler) *DockerServer {
+	client := github.NewClient(nil).WithAuthToken(env.Config.Viper.GetString("token"))
+
 	return &DockerServer{
-		handler: docker.NewHandler(env.Config),
+		endpoints:   engines,
+		repoHandler: gitHandlers.NewRepositoryHandler(client, env.Config),
+		tmplHandler: gitHandlers.NewTemplateHandler(env.Config),
+		pkgHandler:  gitHandlers.NewPackageHandler(gitHandlers.NewQueryHandler(client, env.Config)),
+		jobs:        jobs,
 	}
 }
 
 func (s *DockerServer) StopContainer(ctx context.Context, req *proto.StopContainerRequest) (*proto.StopContainerResponse, error) {
-	if err := s.handler.Container().Stop(ctx, req.ContainerId); err != nil {
+	h, err := s.endpoints.Get(req.Endpoint)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := h.Container().Stop(ctx, req.ContainerId, stopOptions(req.Timeout, req.Signal)); err != nil {
 		return nil, err
 	}
 
@@ -34,8 +65,114 @@ func (s *DockerServer) StopContainer(ctx context.Context, req *proto.StopContain
 	}, nil
 }
 
+func (s *DockerServer) RestartContainer(ctx context.Context, req *proto.RestartContainerRequest) (*proto.RestartContainerResponse, error) {
+	h, err := s.endpoints.Get(req.Endpoint)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := h.Container().Restart(ctx, req.ContainerId, stopOptions(req.Timeout, req.Signal)); err != nil {
+		return nil, err
+	}
+
+	return &proto.RestartContainerResponse{
+		Status: http.StatusOK,
+	}, nil
+}
+
+func (s *DockerServer) KillContainer(ctx context.Context, req *proto.KillContainerRequest) (*proto.KillContainerResponse, error) {
+	h, err := s.endpoints.Get(req.Endpoint)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := h.Container().Kill(ctx, req.ContainerId, req.Signal); err != nil {
+		return nil, err
+	}
+
+	return &proto.KillContainerResponse{
+		Status: http.StatusOK,
+	}, nil
+}
+
+func (s *DockerServer) PauseContainer(ctx context.Context, req *proto.PauseContainerRequest) (*proto.PauseContainerResponse, error) {
+	h, err := s.endpoints.Get(req.Endpoint)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := h.Container().Pause(ctx, req.ContainerId); err != nil {
+		return nil, err
+	}
+
+	return &proto.PauseContainerResponse{
+		Status: http.StatusOK,
+	}, nil
+}
+
+func (s *DockerServer) UnpauseContainer(ctx context.Context, req *proto.UnpauseContainerRequest) (*proto.UnpauseContainerResponse, error) {
+	h, err := s.endpoints.Get(req.Endpoint)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := h.Container().Unpause(ctx, req.ContainerId); err != nil {
+		return nil, err
+	}
+
+	return &proto.UnpauseContainerResponse{
+		Status: http.StatusOK,
+	}, nil
+}
+
+func (s *DockerServer) RenameContainer(ctx context.Context, req *proto.RenameContainerRequest) (*proto.RenameContainerResponse, error) {
+	h, err := s.endpoints.Get(req.Endpoint)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := h.Container().Rename(ctx, req.ContainerId, req.Name); err != nil {
+		return nil, err
+	}
+
+	return &proto.RenameContainerResponse{
+		Status: http.StatusOK,
+	}, nil
+}
+
+func (s *DockerServer) UpdateContainer(ctx context.Context, req *proto.UpdateContainerRequest) (*proto.UpdateContainerResponse, error) {
+	h, err := s.endpoints.Get(req.Endpoint)
+	if err != nil {
+		return nil, err
+	}
+
+	config := container.UpdateConfig{}
+	if req.Resources != nil {
+		config.Resources = resources(req.Resources)
+	}
+
+	if req.RestartPolicy != nil {
+		config.RestartPolicy = restartPolicy(req.RestartPolicy)
+	}
+
+	warnings, err := h.Container().Update(ctx, req.ContainerId, config)
+	if err != nil {
+		return nil, err
+	}
+
+	return &proto.UpdateContainerResponse{
+		Status:   http.StatusOK,
+		Warnings: warnings,
+	}, nil
+}
+
 func (s *DockerServer) StartContainer(ctx context.Context, req *proto.StartContainerRequest) (*proto.StartContainerResponse, error) {
-	if err := s.handler.Container().Start(ctx, req.ContainerId); err != nil {
+	h, err := s.endpoints.Get(req.Endpoint)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := h.Container().Start(ctx, req.ContainerId); err != nil {
 		return nil, err
 	}
 
@@ -45,32 +182,111 @@ func (s *DockerServer) StartContainer(ctx context.Context, req *proto.StartConta
 }
 
 func (s *DockerServer) GetContainers(ctx context.Context, req *proto.GetContainersRequest) (*proto.GetContainersResponse, error) {
-	containers, err := s.handler.Container().GetAll(ctx)
+	h, err := s.endpoints.Get(req.Endpoint)
+	if err != nil {
+		return nil, err
+	}
+
+	filter := filters.NewArgs()
+	for _, label := range req.Labels {
+		filter.Add("label", label)
+	}
+
+	for _, name := range req.Names {
+		filter.Add("name", name)
+	}
+
+	for _, status := range req.Status {
+		filter.Add("status", status)
+	}
+
+	for _, ancestor := range req.Ancestors {
+		filter.Add("ancestor", ancestor)
+	}
+
+	if req.Managed {
+		filter.Add("label", handlers.LabelManaged+"=true")
+	}
 
+	containers, err := h.Container().GetAll(ctx, req.All, filter)
 	if err != nil {
 		return nil, err
 	}
 
-	var resSlice []*proto.Container
-	for _, c := range containers {
-		resSlice = append(resSlice, &proto.Container{
-			Id:      c.ID,
-			Image:   c.Image,
-			Status:  c.Status,
-			Command: c.Command,
-			Created: c.Created,
-			State:   c.State,
-			Names:   c.Names,
+	// pages continue after the last container returned, so containers created or removed in between do not shift them
+	sort.Slice(containers, func(i, j int) bool {
+		return containerBefore(containers[i].Created, containers[i].ID, containers[j].Created, containers[j].ID)
+	})
+
+	offset := 0
+	if req.PageToken != "" {
+		created, id, err := parsePageToken(req.PageToken)
+		if err != nil {
+			return nil, err
+		}
+
+		offset = sort.Search(len(containers), func(i int) bool {
+			return containerBefore(created, id, containers[i].Created, containers[i].ID)
 		})
 	}
 
-	return &proto.GetContainersResponse{
+	end := len(containers)
+	if req.Limit > 0 {
+		end = min(offset+int(req.Limit), end)
+	}
+
+	var resSlice []*proto.Container
+	for _, c := range containers[offset:end] {
+		resSlice = append(resSlice, protoContainer(c))
+	}
+
+	res := &proto.GetContainersResponse{
 		Containers: resSlice,
-	}, nil
+	}
+
+	if end < len(containers) {
+		last := containers[end-1]
+		res.NextPageToken = pageToken(last.Created, last.ID)
+	}
+
+	return res, nil
+}
+
+// containerBefore orders containers newest first, the id breaks ties between containers created in the same second
+func containerBefore(created int64, id string, otherCreated int64, otherId string) bool {
+	if created != otherCreated {
+		return created > otherCreated
+	}
+
+	return id < otherId
+}
+
+func pageToken(created int64, id string) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(strconv.FormatInt(created, 10) + ":" + id))
+}
+
+func parsePageToken(token string) (int64, string, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return 0, "", errors.New("invalid page token")
+	}
+
+	createdString, id, found := strings.Cut(string(raw), ":")
+	created, err := strconv.ParseInt(createdString, 10, 64)
+	if !found || err != nil || id == "" {
+		return 0, "", errors.New("invalid page token")
+	}
+
+	return created, id, nil
 }
 
 func (s *DockerServer) DeleteContainer(ctx context.Context, req *proto.DeleteContainerRequest) (*proto.DeleteContainerResponse, error) {
-	if err := s.handler.Container().Delete(ctx, req.ContainerId); err != nil {
+	h, err := s.endpoints.Get(req.Endpoint)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := h.Container().Delete(ctx, req.ContainerId); err != nil {
 		return nil, err
 	}
 
@@ -80,7 +296,12 @@ func (s *DockerServer) DeleteContainer(ctx context.Context, req *proto.DeleteCon
 }
 
 func (s *DockerServer) GetContainerLogs(ctx context.Context, req *proto.GetContainerLogsRequest) (*proto.GetContainerLogsResponse, error) {
-	logs, err := s.handler.Container().GetLogs(req.ContainerId, ctx)
+	h, err := s.endpoints.Get(req.Endpoint)
+	if err != nil {
+		return nil, err
+	}
+
+	logs, err := h.Container().GetLogs(req.ContainerId, ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -97,36 +318,264 @@ func (s *DockerServer) GetContainerLogs(ctx context.Context, req *proto.GetConta
 	}, nil
 }
 
+func (s *DockerServer) StreamContainerLogs(req *proto.StreamContainerLogsRequest, stream proto.DockerService_StreamContainerLogsServer) error {
+	h, err := s.endpoints.Get(req.Endpoint)
+	if err != nil {
+		return err
+	}
+
+	options := dockerTypes.ContainerLogsOptions{
+		ShowStdout: req.Stdout,
+		ShowStderr: req.Stderr,
+		Since:      req.Since,
+		Until:      req.Until,
+		Timestamps: req.Timestamps,
+		Follow:     req.Follow,
+		Tail:       req.Tail,
+	}
+
+	if !options.ShowStdout && !options.ShowStderr {
+		options.ShowStdout = true
+		options.ShowStderr = true
+	}
+
+	return h.Container().StreamLogs(stream.Context(), req.ContainerId, options, func(line *pkgTypes.ContainerLogLine) error {
+		logStream := proto.LogStream_STDOUT
+		if line.Stream == "stderr" {
+			logStream = proto.LogStream_STDERR
+		}
+
+		return stream.Send(&proto.StreamContainerLogsResponse{
+			Stream:    logStream,
+			Timestamp: line.Timestamp,
+			Content:   line.Content,
+		})
+	})
+}
+
 func (s *DockerServer) CreatePackageContainer(ctx context.Context, req *proto.CreatePackageContainerRequest) (*proto.CreatePackageContainerResponse, error) {
-	if err := s.handler.Container().CreateFrom(ctx, req.Path, req.Name); err != nil {
+	h, err := s.endpoints.Get(req.Endpoint)
+	if err != nil {
+		return nil, err
+	}
+
+	config, hostConfig, networkConfig, err := containerSpec(req.Spec)
+	if err != nil {
+		return nil, err
+	}
+
+	timeout := defaultWaitTimeout
+	if req.WaitTimeout != "" {
+		timeout, err = time.ParseDuration(req.WaitTimeout)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	cId, err := h.Container().CreateFrom(ctx, req.Path, req.Name, req.Owner, config, hostConfig, networkConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	if !req.WaitHealthy {
+		return &proto.CreatePackageContainerResponse{
+			Status:      http.StatusCreated,
+			ContainerId: cId,
+		}, nil
+	}
+
+	// only waiting on the health check can take long enough to need a job
+	j, err := s.jobs.Start("create-package-container", func(ctx context.Context, reporter job.Reporter) error {
+		reporter.Result(pkgTypes.JobResult{
+			Container: &pkgTypes.ContainerResult{ContainerId: cId},
+		})
+		reporter.Progress(0, "waiting for container "+cId+" to become healthy")
+
+		health, err := h.Container().WaitHealthy(ctx, cId, timeout)
+		if err != nil {
+			return err
+		}
+
+		reporter.Result(pkgTypes.JobResult{
+			Container: &pkgTypes.ContainerResult{ContainerId: cId, Health: health},
+		})
+		return nil
+	})
+	if err != nil {
 		return nil, err
 	}
 
 	return &proto.CreatePackageContainerResponse{
-		Status: http.StatusCreated,
+		Status:      http.StatusAccepted,
+		ContainerId: cId,
+		JobId:       j.Id,
 	}, nil
 }
 
 func (s *DockerServer) GetPackageVersionContainers(ctx context.Context, req *proto.GetPackageVersionContainersRequest) (*proto.GetPackageVersionContainersResponse, error) {
-	res, err := s.handler.Container().GetAllFrom(ctx, req.Path)
+	h, err := s.endpoints.Get(req.Endpoint)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := h.Container().GetAllFrom(ctx, req.Path)
 	if err != nil {
 		return nil, err
 	}
 
 	resSlice := make([]*proto.Container, len(res))
-	for index, container := range res {
-		resSlice[index] = &proto.Container{
-			Id:      container.ID,
-			Names:   container.Names,
-			Image:   container.Image,
-			Status:  container.Status,
-			Command: container.Command,
-			State:   container.State,
-			Created: container.Created,
-		}
+	for index, c := range res {
+		resSlice[index] = protoContainer(c)
 	}
 
 	return &proto.GetPackageVersionContainersResponse{
 		Containers: resSlice,
 	}, nil
 }
+
+func (s *DockerServer) GetManagedContainers(ctx context.Context, req *proto.GetManagedContainersRequest) (*proto.GetManagedContainersResponse, error) {
+	h, err := s.endpoints.Get(req.Endpoint)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := h.Container().GetAllManaged(ctx, req.PackageName, req.PackageTag, req.Owner)
+	if err != nil {
+		return nil, err
+	}
+
+	resSlice := make([]*proto.Container, len(res))
+	for index, c := range res {
+		resSlice[index] = protoContainer(c)
+	}
+
+	return &proto.GetManagedContainersResponse{
+		Containers: resSlice,
+	}, nil
+}
+
+func (s *DockerServer) ReconcileContainers(ctx context.Context, req *proto.ReconcileContainersRequest) (*proto.ReconcileContainersResponse, error) {
+	h, err := s.endpoints.Get(req.Endpoint)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := h.Container().Reconcile(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	resSlice := make([]*proto.Container, len(res))
+	for index, c := range res {
+		resSlice[index] = protoContainer(c)
+	}
+
+	return &proto.ReconcileContainersResponse{
+		Containers: resSlice,
+	}, nil
+}
+
+func stopOptions(timeout *int64, signal string) container.StopOptions {
+	options := container.StopOptions{
+		Signal: signal,
+	}
+
+	if timeout != nil {
+		seconds := int(*timeout)
+		options.Timeout = &seconds
+	}
+
+	return options
+}
+
+func protoContainer(c dockerTypes.Container) *proto.Container {
+	res := &proto.Container{
+		Id:        c.ID,
+		Names:     c.Names,
+		Image:     c.Image,
+		Status:    c.Status,
+		Command:   c.Command,
+		State:     c.State,
+		Created:   c.Created,
+		Labels:    c.Labels,
+		Ownership: protoOwnership(c.Labels),
+	}
+
+	for _, port := range c.Ports {
+		res.Ports = append(res.Ports, &proto.ContainerPort{
+			Ip:          port.IP,
+			PrivatePort: uint32(port.PrivatePort),
+			PublicPort:  uint32(port.PublicPort),
+			Type:        port.Type,
+		})
+	}
+
+	res.Mounts = protoMounts(c.Mounts)
+	if c.NetworkSettings != nil {
+		res.Networks = protoNetworks(c.NetworkSettings.Networks)
+	}
+
+	return res
+}
+
+func protoMounts(mounts []dockerTypes.MountPoint) []*proto.ContainerMount {
+	var res []*proto.ContainerMount
+	for _, m := range mounts {
+		res = append(res, &proto.ContainerMount{
+			Type:        string(m.Type),
+			Name:        m.Name,
+			Source:      m.Source,
+			Destination: m.Destination,
+			Mode:        m.Mode,
+			Rw:          m.RW,
+		})
+	}
+
+	return res
+}
+
+func protoNetworks(networks map[string]*network.EndpointSettings) []*proto.ContainerNetwork {
+	names := make([]string, 0, len(networks))
+	for name := range networks {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	var res []*proto.ContainerNetwork
+	for _, name := range names {
+		endpoint := networks[name]
+		res = append(res, &proto.ContainerNetwork{
+			Name:       name,
+			NetworkId:  endpoint.NetworkID,
+			IpAddress:  endpoint.IPAddress,
+			Gateway:    endpoint.Gateway,
+			MacAddress: endpoint.MacAddress,
+		})
+	}
+
+	return res
+}
+
+func protoOwnership(labels map[string]string) *proto.ContainerOwnership {
+	if labels[handlers.LabelManaged] != "true" {
+		return nil
+	}
+
+	return &proto.ContainerOwnership{
+		PackageName: labels[handlers.LabelPackage],
+		PackageTag:  labels[handlers.LabelTag],
+		Version:     labels[handlers.LabelVersion],
+		Owner:       labels[handlers.LabelOwner],
+	}
+}
+
+func protoHealth(health *pkgTypes.ContainerHealth) *proto.ContainerHealth {
+	return &proto.ContainerHealth{
+		State:         health.State,
+		Status:        health.Status,
+		FailingStreak: int64(health.FailingStreak),
+		Output:        health.Output,
+		ExitCode:      int64(health.ExitCode),
+	}
+}
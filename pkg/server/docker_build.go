@@ -0,0 +1,117 @@
+package server
+
+import (
+	"encoding/json"
+	"github.com/alpha-omega-corp/github-svc/pkg/services/docker/handlers"
+	gitHandlers "github.com/alpha-omega-corp/github-svc/pkg/services/github/handlers"
+	proto "github.com/alpha-omega-corp/github-svc/proto/docker"
+	dockerTypes "github.com/docker/docker/api/types"
+	"github.com/docker/docker/pkg/jsonmessage"
+)
+
+const (
+	buildImageId = "moby.image.id"
+	buildTrace   = "moby.buildkit.trace"
+)
+
+func (s *DockerServer) BuildPackage(req *proto.BuildPackageRequest, stream proto.DockerService_BuildPackageServer) error {
+	h, err := s.endpoints.Get(req.Endpoint)
+	if err != nil {
+		return err
+	}
+
+	files, err := s.repoHandler.GetPackageFiles(stream.Context(), req.Path)
+	if err != nil {
+		return err
+	}
+
+	buildContext, err := handlers.BuildContext(contextFiles(files))
+	if err != nil {
+		return err
+	}
+
+	options := dockerTypes.ImageBuildOptions{
+		Tags:       append([]string{h.Image().Name(req.Path)}, req.Tags...),
+		Target:     req.Target,
+		Labels:     req.Labels,
+		NoCache:    req.NoCache,
+		Platform:   req.Platform,
+		PullParent: req.Pull,
+		Remove:     true,
+		BuildArgs:  map[string]*string{},
+	}
+
+	for key, value := range req.BuildArgs {
+		value := value
+		options.BuildArgs[key] = &value
+	}
+
+	if req.Buildkit {
+		options.Version = dockerTypes.BuilderBuildKit
+	}
+
+	return h.Image().Build(stream.Context(), buildContext, options, func(msg *jsonmessage.JSONMessage) error {
+		res, err := buildResponse(msg)
+		if err != nil {
+			return err
+		}
+
+		return stream.Send(res)
+	})
+}
+
+func buildResponse(msg *jsonmessage.JSONMessage) (*proto.BuildPackageResponse, error) {
+	res := &proto.BuildPackageResponse{
+		Stream: msg.Stream,
+		Id:     msg.ID,
+		Status: msg.Status,
+	}
+
+	if msg.Progress != nil {
+		res.Current = msg.Progress.Current
+		res.Total = msg.Progress.Total
+	}
+
+	if msg.Aux == nil {
+		return res, nil
+	}
+
+	switch msg.ID {
+	case buildTrace:
+		// buildkit status updates are base64 encoded protobuf, they are forwarded as is
+		if err := json.Unmarshal(*msg.Aux, &res.Trace); err != nil {
+			return nil, err
+		}
+
+		res.Id = ""
+	case buildImageId:
+		var result dockerTypes.BuildResult
+		if err := json.Unmarshal(*msg.Aux, &result); err != nil {
+			return nil, err
+		}
+
+		res.ImageId = result.ID
+		res.Id = ""
+	default:
+		// the classic builder reports the image id without a message id
+		var result dockerTypes.BuildResult
+		if err := json.Unmarshal(*msg.Aux, &result); err == nil {
+			res.ImageId = result.ID
+		}
+	}
+
+	return res, nil
+}
+
+func contextFiles(files []*gitHandlers.PackageFile) []*handlers.ContextFile {
+	res := make([]*handlers.ContextFile, len(files))
+	for index, file := range files {
+		res[index] = &handlers.ContextFile{
+			Name:    file.Name,
+			Mode:    file.Mode,
+			Content: file.Content,
+		}
+	}
+
+	return res
+}
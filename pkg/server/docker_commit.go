@@ -0,0 +1,149 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	proto "github.com/alpha-omega-corp/github-svc/proto/docker"
+	dockerTypes "github.com/docker/docker/api/types"
+	"github.com/google/go-github/v56/github"
+	"google.golang.org/protobuf/encoding/protojson"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// commits are described next to the Dockerfile of the package version they created
+const commitFile = "commit.json"
+
+func (s *DockerServer) CommitContainer(ctx context.Context, req *proto.CommitContainerRequest) (*proto.CommitContainerResponse, error) {
+	h, err := s.endpoints.Get(req.Endpoint)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Tag == "" {
+		return nil, errors.New("a tag is required to commit a container")
+	}
+
+	info, err := h.Container().Inspect(ctx, req.ContainerId)
+	if err != nil {
+		return nil, err
+	}
+
+	source := protoOwnership(info.Config.Labels)
+
+	pkgName := req.Name
+	if pkgName == "" {
+		if source == nil {
+			return nil, errors.New("container " + req.ContainerId + " is not managed, a package name is required")
+		}
+
+		pkgName = source.PackageName
+	}
+
+	path := pkgName + "/" + req.Tag
+	if err := s.ensurePackageVersionAbsent(ctx, path); err != nil {
+		return nil, err
+	}
+
+	imgName := h.Image().Name(path)
+	imgId, err := h.Container().Commit(ctx, info.ID, dockerTypes.ContainerCommitOptions{
+		Reference: imgName,
+		Comment:   req.Comment,
+		Author:    req.Author,
+		Changes:   req.Changes,
+		Pause:     !req.NoPause,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	digest, err := h.Image().Push(ctx, imgName, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	commit := &proto.ContainerCommit{
+		ContainerId:   info.ID,
+		ContainerName: strings.TrimPrefix(info.Name, "/"),
+		SourceImage:   info.Config.Image,
+		Source:        source,
+		ImageId:       imgId,
+		Image:         imgName,
+		Comment:       req.Comment,
+		Author:        req.Author,
+		Changes:       req.Changes,
+		Created:       time.Now().UTC().Format(time.RFC3339),
+		Digest:        digest,
+	}
+
+	// the Dockerfile needs the digest of the push, an image left without it would not belong to any package version
+	base := h.Image().Name(pkgName) + "@" + digest
+	if err := s.putCommitFiles(ctx, path, base, commit); err != nil {
+		if delErr := s.deletePackageVersion(pkgName, digest); delErr != nil {
+			return nil, errors.Join(err, delErr)
+		}
+
+		return nil, err
+	}
+
+	return &proto.CommitContainerResponse{
+		Status: http.StatusCreated,
+		Path:   path,
+		Commit: commit,
+	}, nil
+}
+
+func (s *DockerServer) ensurePackageVersionAbsent(ctx context.Context, path string) error {
+	_, err := s.repoHandler.GetContents(ctx, repository, path+"/Dockerfile")
+	if err == nil {
+		return errors.New("package version " + path + " already exists")
+	}
+
+	var resErr *github.ErrorResponse
+	if !errors.As(err, &resErr) || resErr.Response.StatusCode != http.StatusNotFound {
+		return err
+	}
+
+	return nil
+}
+
+// putCommitFiles builds the package version on the pushed image, pinned so moving its tag does not change it
+func (s *DockerServer) putCommitFiles(ctx context.Context, path string, base string, commit *proto.ContainerCommit) error {
+	content := fmt.Sprintf("# committed from container %s (%s), originally running %s\n# see %s for details\nFROM %s",
+		commit.ContainerName, commit.ContainerId, commit.SourceImage, commitFile, base)
+
+	name, tag, _ := strings.Cut(path, "/")
+	dockerfile, err := s.tmplHandler.CreateDockerfile(name, tag, []byte(content))
+	if err != nil {
+		return err
+	}
+
+	if err := s.repoHandler.PutContents(ctx, repository, path+"/Dockerfile", dockerfile.Bytes(), nil); err != nil {
+		return err
+	}
+
+	file, err := protojson.MarshalOptions{Multiline: true}.Marshal(commit)
+	if err != nil {
+		return err
+	}
+
+	return s.repoHandler.PutContents(ctx, repository, path+"/"+commitFile, file, nil)
+}
+
+// deletePackageVersion removes the pushed image, ghcr names container package versions after their manifest digest
+func (s *DockerServer) deletePackageVersion(pkgName string, digest string) error {
+	versions, err := s.pkgHandler.GetVersions(pkgName)
+	if err != nil {
+		return err
+	}
+
+	for _, version := range versions {
+		if version.Name == digest {
+			return s.pkgHandler.Delete(pkgName, &version.Id)
+		}
+	}
+
+	return errors.New("package version " + digest + " of " + pkgName + " was not found")
+}
@@ -0,0 +1,157 @@
+package server
+
+import (
+	"context"
+	"errors"
+	proto "github.com/alpha-omega-corp/github-svc/proto/docker"
+	dockerTypes "github.com/docker/docker/api/types"
+	"io"
+	"log"
+	"net/http"
+	"time"
+)
+
+const copyChunkSize = 32 * 1024
+
+func (s *DockerServer) CopyToContainer(stream proto.DockerService_CopyToContainerServer) error {
+	req, err := stream.Recv()
+	if err != nil {
+		return err
+	}
+
+	target := req.GetTarget()
+	if target == nil {
+		return errors.New("the first copy message must be a target")
+	}
+
+	h, err := s.endpoints.Get(target.Endpoint)
+	if err != nil {
+		return err
+	}
+
+	content, contentWriter := io.Pipe()
+
+	// the receive loop only owns size until done is closed
+	var size int64
+	done := make(chan struct{})
+
+	go func() {
+		defer close(done)
+
+		for {
+			msg, err := stream.Recv()
+			if err != nil {
+				if errors.Is(err, io.EOF) {
+					err = nil
+				}
+
+				_ = contentWriter.CloseWithError(err)
+				return
+			}
+
+			n, err := contentWriter.Write(msg.GetData())
+			size += int64(n)
+
+			if err != nil {
+				return
+			}
+		}
+	}()
+
+	if err := h.Container().CopyTo(stream.Context(), target.ContainerId, target.Path, content, dockerTypes.CopyToContainerOptions{
+		AllowOverwriteDirWithFile: target.AllowOverwriteDirWithFile,
+		CopyUIDGID:                target.CopyUidGid,
+	}); err != nil {
+		// unblocks a pending write, the receive loop ends with the stream
+		_ = content.CloseWithError(err)
+		return err
+	}
+
+	select {
+	case <-done:
+	case <-stream.Context().Done():
+		_ = content.CloseWithError(stream.Context().Err())
+		return stream.Context().Err()
+	}
+
+	_ = content.Close()
+
+	return stream.SendAndClose(&proto.CopyToContainerResponse{
+		Status: http.StatusOK,
+		Size:   size,
+	})
+}
+
+func (s *DockerServer) CopyFromContainer(req *proto.CopyFromContainerRequest, stream proto.DockerService_CopyFromContainerServer) error {
+	h, err := s.endpoints.Get(req.Endpoint)
+	if err != nil {
+		return err
+	}
+
+	content, stat, err := h.Container().CopyFrom(stream.Context(), req.ContainerId, req.Path)
+	if err != nil {
+		return err
+	}
+
+	defer func(content io.ReadCloser) {
+		if err := content.Close(); err != nil {
+			log.Printf("failed to close the archive of container %s: %v", req.ContainerId, err)
+		}
+	}(content)
+
+	if err := stream.Send(&proto.CopyFromContainerResponse{
+		Stat: protoPathStat(stat),
+	}); err != nil {
+		return err
+	}
+
+	buf := make([]byte, copyChunkSize)
+	for {
+		n, err := content.Read(buf)
+		if n > 0 {
+			data := make([]byte, n)
+			copy(data, buf[:n])
+
+			if err := stream.Send(&proto.CopyFromContainerResponse{
+				Data: data,
+			}); err != nil {
+				return err
+			}
+		}
+
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+
+		if err != nil {
+			return err
+		}
+	}
+}
+
+func (s *DockerServer) StatContainerPath(ctx context.Context, req *proto.StatContainerPathRequest) (*proto.StatContainerPathResponse, error) {
+	h, err := s.endpoints.Get(req.Endpoint)
+	if err != nil {
+		return nil, err
+	}
+
+	stat, err := h.Container().StatPath(ctx, req.ContainerId, req.Path)
+	if err != nil {
+		return nil, err
+	}
+
+	return &proto.StatContainerPathResponse{
+		Stat: protoPathStat(stat),
+	}, nil
+}
+
+func protoPathStat(stat dockerTypes.ContainerPathStat) *proto.ContainerPathStat {
+	return &proto.ContainerPathStat{
+		Name:       stat.Name,
+		Size:       stat.Size,
+		Mode:       uint32(stat.Mode),
+		Modified:   stat.Mtime.Format(time.RFC3339Nano),
+		LinkTarget: stat.LinkTarget,
+		Dir:        stat.Mode.IsDir(),
+	}
+}
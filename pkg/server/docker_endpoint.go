@@ -0,0 +1,87 @@
+package server
+
+import (
+	"context"
+	"github.com/alpha-omega-corp/github-svc/pkg/services/docker"
+	proto "github.com/alpha-omega-corp/github-svc/proto/docker"
+	"sync"
+	"time"
+)
+
+const endpointTimeout = 5 * time.Second
+
+func (s *DockerServer) ListEndpoints(ctx context.Context, req *proto.ListEndpointsRequest) (*proto.ListEndpointsResponse, error) {
+	endpoints := s.endpoints.GetAll()
+	resSlice := make([]*proto.Endpoint, len(endpoints))
+
+	// unreachable engines only cost their timeout once
+	var wg sync.WaitGroup
+	for index, endpoint := range endpoints {
+		resSlice[index] = &proto.Endpoint{
+			Name:    endpoint.Name,
+			Host:    endpoint.Host,
+			Default: endpoint.Name == s.endpoints.Default(),
+		}
+
+		// one broken endpoint must not hide the others
+		h, err := s.endpoints.Get(endpoint.Name)
+		if err != nil {
+			resSlice[index].Error = err.Error()
+			continue
+		}
+
+		wg.Add(1)
+		go func(res *proto.Endpoint, h docker.Handler) {
+			defer wg.Done()
+
+			ctx, cancel := context.WithTimeout(ctx, endpointTimeout)
+			defer cancel()
+
+			version, err := h.Engine().Version(ctx)
+			if err != nil {
+				res.Error = err.Error()
+				return
+			}
+
+			res.Reachable = true
+			res.Version = version.Version
+			res.ApiVersion = version.APIVersion
+			res.Os = version.Os
+			res.Arch = version.Arch
+		}(resSlice[index], h)
+	}
+
+	wg.Wait()
+
+	return &proto.ListEndpointsResponse{
+		Endpoints: resSlice,
+	}, nil
+}
+
+func (s *DockerServer) GetEngineStatus(ctx context.Context, req *proto.GetEngineStatusRequest) (*proto.GetEngineStatusResponse, error) {
+	status, err := s.endpoints.Status(req.Endpoint)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &proto.EngineStatus{
+		Endpoint:   status.Endpoint,
+		State:      status.State,
+		ApiVersion: status.ApiVersion,
+		OsType:     status.OSType,
+		Failures:   int64(status.Failures),
+		LastError:  status.LastError,
+	}
+
+	if !status.LastPing.IsZero() {
+		res.LastPing = status.LastPing.Format(time.RFC3339)
+	}
+
+	if !status.ConnectedAt.IsZero() {
+		res.ConnectedAt = status.ConnectedAt.Format(time.RFC3339)
+	}
+
+	return &proto.GetEngineStatusResponse{
+		Status: res,
+	}, nil
+}
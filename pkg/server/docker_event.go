@@ -0,0 +1,69 @@
+package server
+
+import (
+	"github.com/alpha-omega-corp/github-svc/pkg/services/docker"
+	proto "github.com/alpha-omega-corp/github-svc/proto/docker"
+	"github.com/docker/docker/api/types/events"
+	"github.com/docker/docker/api/types/filters"
+	"strings"
+)
+
+func (s *DockerServer) WatchEvents(req *proto.WatchEventsRequest, stream proto.DockerService_WatchEventsServer) error {
+	h, err := s.endpoints.Get(req.Endpoint)
+	if err != nil {
+		return err
+	}
+
+	filter := filters.NewArgs()
+	for _, c := range req.Containers {
+		filter.Add("container", c)
+	}
+
+	for _, img := range req.Images {
+		filter.Add("image", img)
+	}
+
+	for _, label := range req.Labels {
+		filter.Add("label", label)
+	}
+
+	for _, t := range req.Types {
+		filter.Add("type", t)
+	}
+
+	for _, action := range req.Actions {
+		filter.Add("event", action)
+	}
+
+	return h.Event().Watch(stream.Context(), req.Since, req.Until, filter, func(msg events.Message) error {
+		return stream.Send(&proto.WatchEventsResponse{
+			Event: event(h, msg),
+		})
+	})
+}
+
+func event(h docker.Handler, msg events.Message) *proto.Event {
+	imgName := msg.Actor.Attributes["image"]
+	if msg.Type == events.ImageEventType {
+		imgName = msg.Actor.ID
+	}
+
+	evt := &proto.Event{
+		Type:       msg.Type,
+		Action:     msg.Action,
+		ActorId:    msg.Actor.ID,
+		ActorName:  msg.Actor.Attributes["name"],
+		Image:      imgName,
+		Scope:      msg.Scope,
+		Time:       msg.TimeNano,
+		Attributes: msg.Actor.Attributes,
+	}
+
+	if path, ok := h.Image().Path(imgName); ok {
+		name, tag, _ := strings.Cut(path, "/")
+		evt.PackageName = &name
+		evt.PackageTag = &tag
+	}
+
+	return evt
+}
@@ -0,0 +1,148 @@
+package server
+
+import (
+	"context"
+	"errors"
+	pkgTypes "github.com/alpha-omega-corp/github-svc/pkg/types"
+	proto "github.com/alpha-omega-corp/github-svc/proto/docker"
+	dockerTypes "github.com/docker/docker/api/types"
+	"io"
+)
+
+// stdin frames are buffered so that short pauses in reading do not delay resizes, a slow reader applies backpressure
+const stdinFrames = 256
+
+type execWriter struct {
+	stream    proto.DockerService_ExecContainerServer
+	logStream proto.LogStream
+}
+
+func (w *execWriter) Write(p []byte) (int, error) {
+	data := make([]byte, len(p))
+	copy(data, p)
+
+	if err := w.stream.Send(&proto.ExecContainerResponse{
+		Stream: w.logStream,
+		Data:   data,
+	}); err != nil {
+		return 0, err
+	}
+
+	return len(p), nil
+}
+
+func (s *DockerServer) ExecContainer(stream proto.DockerService_ExecContainerServer) error {
+	req, err := stream.Recv()
+	if err != nil {
+		return err
+	}
+
+	start := req.GetStart()
+	if start == nil {
+		return errors.New("the first exec message must be a start request")
+	}
+
+	h, err := s.endpoints.Get(start.Endpoint)
+	if err != nil {
+		return err
+	}
+
+	config := dockerTypes.ExecConfig{
+		User:       start.User,
+		Tty:        start.Tty,
+		Env:        start.Env,
+		WorkingDir: start.WorkingDir,
+		Cmd:        start.Cmd,
+	}
+
+	if start.Size != nil {
+		config.ConsoleSize = &[2]uint{uint(start.Size.Height), uint(start.Size.Width)}
+	}
+
+	ctx, cancel := context.WithCancelCause(stream.Context())
+	defer cancel(nil)
+
+	resize := make(chan pkgTypes.TerminalSize)
+	execStream := &pkgTypes.ExecStream{
+		Stdout: &execWriter{stream: stream, logStream: proto.LogStream_STDOUT},
+		Stderr: &execWriter{stream: stream, logStream: proto.LogStream_STDERR},
+		Resize: resize,
+	}
+
+	stdin, stdinWriter := io.Pipe()
+	defer stdin.Close()
+
+	frames := make(chan []byte, stdinFrames)
+	if start.Stdin {
+		execStream.Stdin = stdin
+
+		go func() {
+			for data := range frames {
+				if _, err := stdinWriter.Write(data); err != nil {
+					return
+				}
+			}
+
+			_ = stdinWriter.Close()
+		}()
+	}
+
+	go func() {
+		// stdin frames are dropped when stdin was not requested or is already closed
+		stdinOpen := start.Stdin
+		defer func() {
+			if stdinOpen {
+				close(frames)
+			}
+		}()
+
+		for {
+			msg, err := stream.Recv()
+			if err != nil {
+				if !errors.Is(err, io.EOF) {
+					cancel(err)
+				}
+
+				return
+			}
+
+			switch payload := msg.Payload.(type) {
+			case *proto.ExecContainerRequest_Stdin:
+				if !stdinOpen {
+					continue
+				}
+
+				select {
+				case frames <- payload.Stdin:
+				case <-ctx.Done():
+					return
+				}
+			case *proto.ExecContainerRequest_CloseStdin:
+				if stdinOpen {
+					stdinOpen = false
+					close(frames)
+				}
+			case *proto.ExecContainerRequest_Resize:
+				select {
+				case resize <- pkgTypes.TerminalSize{Height: uint(payload.Resize.Height), Width: uint(payload.Resize.Width)}:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}
+	}()
+
+	exitCode, err := h.Container().Exec(ctx, start.ContainerId, config, execStream)
+	if err != nil {
+		if cause := context.Cause(ctx); cause != nil {
+			return cause
+		}
+
+		return err
+	}
+
+	code := int64(exitCode)
+	return stream.Send(&proto.ExecContainerResponse{
+		ExitCode: &code,
+	})
+}
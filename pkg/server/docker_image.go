@@ -0,0 +1,219 @@
+package server
+
+import (
+	"context"
+	proto "github.com/alpha-omega-corp/github-svc/proto/docker"
+	"github.com/docker/docker/api/types/filters"
+	"github.com/docker/docker/pkg/jsonmessage"
+	"net/http"
+	"sort"
+)
+
+func (s *DockerServer) GetImages(ctx context.Context, req *proto.GetImagesRequest) (*proto.GetImagesResponse, error) {
+	h, err := s.endpoints.Get(req.Endpoint)
+	if err != nil {
+		return nil, err
+	}
+
+	filter := filters.NewArgs()
+	if req.Reference != "" {
+		filter.Add("reference", req.Reference)
+	}
+
+	for _, label := range req.Labels {
+		filter.Add("label", label)
+	}
+
+	images, err := h.Image().GetAll(ctx, req.All, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	resSlice := make([]*proto.Image, len(images))
+	for index, img := range images {
+		resSlice[index] = &proto.Image{
+			Id:          img.ID,
+			RepoTags:    img.RepoTags,
+			RepoDigests: img.RepoDigests,
+			Created:     img.Created,
+			Size:        img.Size,
+			Containers:  img.Containers,
+			Labels:      img.Labels,
+		}
+	}
+
+	return &proto.GetImagesResponse{
+		Images: resSlice,
+	}, nil
+}
+
+func (s *DockerServer) InspectImage(ctx context.Context, req *proto.InspectImageRequest) (*proto.InspectImageResponse, error) {
+	h, err := s.endpoints.Get(req.Endpoint)
+	if err != nil {
+		return nil, err
+	}
+
+	img, err := h.Image().Inspect(ctx, req.ImageId)
+	if err != nil {
+		return nil, err
+	}
+
+	details := &proto.ImageDetails{
+		Id:           img.ID,
+		RepoTags:     img.RepoTags,
+		RepoDigests:  img.RepoDigests,
+		Parent:       img.Parent,
+		Comment:      img.Comment,
+		Created:      img.Created,
+		Author:       img.Author,
+		Architecture: img.Architecture,
+		Os:           img.Os,
+		Size:         img.Size,
+		Layers:       img.RootFS.Layers,
+	}
+
+	if img.Config != nil {
+		details.Labels = img.Config.Labels
+		details.Env = img.Config.Env
+		details.Cmd = img.Config.Cmd
+		details.Entrypoint = img.Config.Entrypoint
+		details.WorkingDir = img.Config.WorkingDir
+
+		for port := range img.Config.ExposedPorts {
+			details.ExposedPorts = append(details.ExposedPorts, string(port))
+		}
+
+		sort.Strings(details.ExposedPorts)
+	}
+
+	return &proto.InspectImageResponse{
+		Image: details,
+	}, nil
+}
+
+func (s *DockerServer) PullImage(req *proto.PullImageRequest, stream proto.DockerService_PullImageServer) error {
+	h, err := s.endpoints.Get(req.Endpoint)
+	if err != nil {
+		return err
+	}
+
+	imgName := req.Image
+	if req.Path != "" {
+		imgName = h.Image().Name(req.Path)
+	}
+
+	return h.Image().Pull(stream.Context(), imgName, func(msg *jsonmessage.JSONMessage) error {
+		res := &proto.PullImageResponse{
+			Id:     msg.ID,
+			Status: msg.Status,
+		}
+
+		if msg.Progress != nil {
+			res.Current = msg.Progress.Current
+			res.Total = msg.Progress.Total
+		}
+
+		return stream.Send(res)
+	})
+}
+
+func (s *DockerServer) PushImage(req *proto.PushImageRequest, stream proto.DockerService_PushImageServer) error {
+	h, err := s.endpoints.Get(req.Endpoint)
+	if err != nil {
+		return err
+	}
+
+	imgName := req.Image
+	if req.Path != "" {
+		imgName = h.Image().Name(req.Path)
+	}
+
+	digest, err := h.Image().Push(stream.Context(), imgName, func(msg *jsonmessage.JSONMessage) error {
+		res := &proto.PushImageResponse{
+			Id:     msg.ID,
+			Status: msg.Status,
+		}
+
+		if msg.Progress != nil {
+			res.Current = msg.Progress.Current
+			res.Total = msg.Progress.Total
+		}
+
+		return stream.Send(res)
+	})
+	if err != nil {
+		return err
+	}
+
+	return stream.Send(&proto.PushImageResponse{
+		Digest: digest,
+	})
+}
+
+func (s *DockerServer) RemoveImage(ctx context.Context, req *proto.RemoveImageRequest) (*proto.RemoveImageResponse, error) {
+	h, err := s.endpoints.Get(req.Endpoint)
+	if err != nil {
+		return nil, err
+	}
+
+	items, err := h.Image().Remove(ctx, req.ImageId, req.Force, req.NoPrune)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &proto.RemoveImageResponse{
+		Status: http.StatusOK,
+	}
+
+	for _, item := range items {
+		if item.Untagged != "" {
+			res.Untagged = append(res.Untagged, item.Untagged)
+		}
+
+		if item.Deleted != "" {
+			res.Deleted = append(res.Deleted, item.Deleted)
+		}
+	}
+
+	return res, nil
+}
+
+func (s *DockerServer) PruneImages(ctx context.Context, req *proto.PruneImagesRequest) (*proto.PruneImagesResponse, error) {
+	h, err := s.endpoints.Get(req.Endpoint)
+	if err != nil {
+		return nil, err
+	}
+
+	report, err := h.Image().Prune(ctx, req.All)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &proto.PruneImagesResponse{
+		Status:         http.StatusOK,
+		SpaceReclaimed: report.SpaceReclaimed,
+	}
+
+	for _, item := range report.ImagesDeleted {
+		if item.Deleted != "" {
+			res.Deleted = append(res.Deleted, item.Deleted)
+		}
+	}
+
+	return res, nil
+}
+
+func (s *DockerServer) TagImage(ctx context.Context, req *proto.TagImageRequest) (*proto.TagImageResponse, error) {
+	h, err := s.endpoints.Get(req.Endpoint)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := h.Image().Tag(ctx, req.Source, req.Target); err != nil {
+		return nil, err
+	}
+
+	return &proto.TagImageResponse{
+		Status: http.StatusCreated,
+	}, nil
+}
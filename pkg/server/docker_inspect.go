@@ -0,0 +1,173 @@
+package server
+
+import (
+	"context"
+	"github.com/alpha-omega-corp/github-svc/pkg/services/docker/handlers"
+	proto "github.com/alpha-omega-corp/github-svc/proto/docker"
+	dockerTypes "github.com/docker/docker/api/types"
+	"github.com/docker/docker/api/types/container"
+	"github.com/docker/go-connections/nat"
+	"sort"
+	"strings"
+)
+
+func (s *DockerServer) InspectContainer(ctx context.Context, req *proto.InspectContainerRequest) (*proto.InspectContainerResponse, error) {
+	h, err := s.endpoints.Get(req.Endpoint)
+	if err != nil {
+		return nil, err
+	}
+
+	info, err := h.Container().Inspect(ctx, req.ContainerId)
+	if err != nil {
+		return nil, err
+	}
+
+	details := &proto.ContainerDetails{
+		Id:           info.ID,
+		Name:         strings.TrimPrefix(info.Name, "/"),
+		Created:      info.Created,
+		ImageId:      info.Image,
+		Path:         info.Path,
+		Args:         info.Args,
+		RestartCount: int64(info.RestartCount),
+		Platform:     info.Platform,
+		Driver:       info.Driver,
+		Mounts:       protoMounts(info.Mounts),
+	}
+
+	if info.State != nil {
+		details.State = protoState(info.State)
+	}
+
+	if info.Config != nil {
+		details.Image = info.Config.Image
+		details.Labels = info.Config.Labels
+		details.Ownership = protoOwnership(info.Config.Labels)
+		details.Config = protoConfig(info.Config)
+	}
+
+	if info.HostConfig != nil {
+		details.HostConfig = protoHostConfig(info.HostConfig)
+	}
+
+	if info.NetworkSettings != nil {
+		details.Networks = protoNetworks(info.NetworkSettings.Networks)
+		details.Ports = protoPortBindings(info.NetworkSettings.Ports)
+	}
+
+	return &proto.InspectContainerResponse{
+		Container: details,
+	}, nil
+}
+
+func protoState(state *dockerTypes.ContainerState) *proto.ContainerState {
+	return &proto.ContainerState{
+		Status:     state.Status,
+		Running:    state.Running,
+		Paused:     state.Paused,
+		Restarting: state.Restarting,
+		OomKilled:  state.OOMKilled,
+		Dead:       state.Dead,
+		Pid:        int64(state.Pid),
+		ExitCode:   int64(state.ExitCode),
+		Error:      state.Error,
+		StartedAt:  state.StartedAt,
+		FinishedAt: state.FinishedAt,
+		Health:     protoHealth(handlers.ContainerHealth(state)),
+	}
+}
+
+func protoConfig(config *container.Config) *proto.ContainerConfig {
+	res := &proto.ContainerConfig{
+		Hostname:   config.Hostname,
+		User:       config.User,
+		Env:        config.Env,
+		Cmd:        config.Cmd,
+		Entrypoint: config.Entrypoint,
+		WorkingDir: config.WorkingDir,
+		Tty:        config.Tty,
+		OpenStdin:  config.OpenStdin,
+		StopSignal: config.StopSignal,
+	}
+
+	for port := range config.ExposedPorts {
+		res.ExposedPorts = append(res.ExposedPorts, string(port))
+	}
+
+	sort.Strings(res.ExposedPorts)
+
+	if config.Healthcheck != nil {
+		res.Healthcheck = &proto.Healthcheck{
+			Test:    config.Healthcheck.Test,
+			Retries: int64(config.Healthcheck.Retries),
+		}
+
+		if config.Healthcheck.Interval != 0 {
+			res.Healthcheck.Interval = config.Healthcheck.Interval.String()
+		}
+
+		if config.Healthcheck.Timeout != 0 {
+			res.Healthcheck.Timeout = config.Healthcheck.Timeout.String()
+		}
+
+		if config.Healthcheck.StartPeriod != 0 {
+			res.Healthcheck.StartPeriod = config.Healthcheck.StartPeriod.String()
+		}
+	}
+
+	return res
+}
+
+func protoHostConfig(hostConfig *container.HostConfig) *proto.ContainerHostConfig {
+	return &proto.ContainerHostConfig{
+		NetworkMode: string(hostConfig.NetworkMode),
+		RestartPolicy: &proto.RestartPolicy{
+			Name:              hostConfig.RestartPolicy.Name,
+			MaximumRetryCount: int64(hostConfig.RestartPolicy.MaximumRetryCount),
+		},
+		Resources: &proto.Resources{
+			Cpus:       float64(hostConfig.NanoCPUs) / 1e9,
+			CpuShares:  hostConfig.CPUShares,
+			Memory:     hostConfig.Memory,
+			MemorySwap: hostConfig.MemorySwap,
+		},
+		Binds:          hostConfig.Binds,
+		AutoRemove:     hostConfig.AutoRemove,
+		Privileged:     hostConfig.Privileged,
+		ReadonlyRootfs: hostConfig.ReadonlyRootfs,
+	}
+}
+
+func protoPortBindings(ports nat.PortMap) []*proto.PortBinding {
+	keys := make([]string, 0, len(ports))
+	for port := range ports {
+		keys = append(keys, string(port))
+	}
+
+	sort.Strings(keys)
+
+	var res []*proto.PortBinding
+	for _, key := range keys {
+		port := nat.Port(key)
+		bindings := ports[port]
+
+		// exposed but unpublished ports have no host binding
+		if len(bindings) == 0 {
+			res = append(res, &proto.PortBinding{
+				ContainerPort: port.Port(),
+				Protocol:      port.Proto(),
+			})
+		}
+
+		for _, binding := range bindings {
+			res = append(res, &proto.PortBinding{
+				ContainerPort: port.Port(),
+				Protocol:      port.Proto(),
+				HostIp:        binding.HostIP,
+				HostPort:      binding.HostPort,
+			})
+		}
+	}
+
+	return res
+}
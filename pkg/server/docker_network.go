@@ -0,0 +1,167 @@
+package server
+
+import (
+	"context"
+	"github.com/alpha-omega-corp/github-svc/pkg/services/docker/handlers"
+	proto "github.com/alpha-omega-corp/github-svc/proto/docker"
+	dockerTypes "github.com/docker/docker/api/types"
+	"github.com/docker/docker/api/types/filters"
+	"net/http"
+	"sort"
+	"time"
+)
+
+func (s *DockerServer) CreateNetwork(ctx context.Context, req *proto.CreateNetworkRequest) (*proto.CreateNetworkResponse, error) {
+	h, err := s.endpoints.Get(req.Endpoint)
+	if err != nil {
+		return nil, err
+	}
+
+	nId, err := h.Network().Create(ctx, req.Name, req.PackageName, dockerTypes.NetworkCreate{
+		Driver:     req.Driver,
+		Internal:   req.Internal,
+		Attachable: req.Attachable,
+		Labels:     req.Labels,
+		Options:    req.Options,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &proto.CreateNetworkResponse{
+		Status:    http.StatusCreated,
+		NetworkId: nId,
+	}, nil
+}
+
+func (s *DockerServer) GetNetworks(ctx context.Context, req *proto.GetNetworksRequest) (*proto.GetNetworksResponse, error) {
+	h, err := s.endpoints.Get(req.Endpoint)
+	if err != nil {
+		return nil, err
+	}
+
+	filter := filters.NewArgs()
+	if req.PackageName != "" {
+		filter.Add("label", handlers.LabelPackage+"="+req.PackageName)
+	}
+
+	for _, name := range req.Names {
+		filter.Add("name", name)
+	}
+
+	for _, label := range req.Labels {
+		filter.Add("label", label)
+	}
+
+	networks, err := h.Network().GetAll(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	resSlice := make([]*proto.Network, len(networks))
+	for index, n := range networks {
+		resSlice[index] = protoNetwork(&n)
+	}
+
+	return &proto.GetNetworksResponse{
+		Networks: resSlice,
+	}, nil
+}
+
+func (s *DockerServer) InspectNetwork(ctx context.Context, req *proto.InspectNetworkRequest) (*proto.InspectNetworkResponse, error) {
+	h, err := s.endpoints.Get(req.Endpoint)
+	if err != nil {
+		return nil, err
+	}
+
+	n, err := h.Network().Inspect(ctx, req.NetworkId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &proto.InspectNetworkResponse{
+		Network: protoNetwork(n),
+	}, nil
+}
+
+func (s *DockerServer) DeleteNetwork(ctx context.Context, req *proto.DeleteNetworkRequest) (*proto.DeleteNetworkResponse, error) {
+	h, err := s.endpoints.Get(req.Endpoint)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := h.Network().Delete(ctx, req.NetworkId); err != nil {
+		return nil, err
+	}
+
+	return &proto.DeleteNetworkResponse{
+		Status: http.StatusOK,
+	}, nil
+}
+
+func (s *DockerServer) ConnectNetwork(ctx context.Context, req *proto.ConnectNetworkRequest) (*proto.ConnectNetworkResponse, error) {
+	h, err := s.endpoints.Get(req.Endpoint)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := h.Network().Connect(ctx, req.NetworkId, req.ContainerId, req.Aliases); err != nil {
+		return nil, err
+	}
+
+	return &proto.ConnectNetworkResponse{
+		Status: http.StatusOK,
+	}, nil
+}
+
+func (s *DockerServer) DisconnectNetwork(ctx context.Context, req *proto.DisconnectNetworkRequest) (*proto.DisconnectNetworkResponse, error) {
+	h, err := s.endpoints.Get(req.Endpoint)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := h.Network().Disconnect(ctx, req.NetworkId, req.ContainerId, req.Force); err != nil {
+		return nil, err
+	}
+
+	return &proto.DisconnectNetworkResponse{
+		Status: http.StatusOK,
+	}, nil
+}
+
+func protoNetwork(n *dockerTypes.NetworkResource) *proto.Network {
+	res := &proto.Network{
+		Id:         n.ID,
+		Name:       n.Name,
+		Driver:     n.Driver,
+		Scope:      n.Scope,
+		Created:    n.Created.Format(time.RFC3339),
+		Internal:   n.Internal,
+		Attachable: n.Attachable,
+		Labels:     n.Labels,
+		Options:    n.Options,
+	}
+
+	for _, config := range n.IPAM.Config {
+		res.Subnets = append(res.Subnets, config.Subnet)
+	}
+
+	ids := make([]string, 0, len(n.Containers))
+	for id := range n.Containers {
+		ids = append(ids, id)
+	}
+
+	sort.Strings(ids)
+	for _, id := range ids {
+		endpoint := n.Containers[id]
+		res.Endpoints = append(res.Endpoints, &proto.NetworkEndpoint{
+			ContainerId: id,
+			Name:        endpoint.Name,
+			Ipv4Address: endpoint.IPv4Address,
+			Ipv6Address: endpoint.IPv6Address,
+			MacAddress:  endpoint.MacAddress,
+		})
+	}
+
+	return res
+}
@@ -0,0 +1,161 @@
+package server
+
+import (
+	proto "github.com/alpha-omega-corp/github-svc/proto/docker"
+	"github.com/docker/docker/api/types/container"
+	"github.com/docker/docker/api/types/mount"
+	"github.com/docker/docker/api/types/network"
+	"github.com/docker/go-connections/nat"
+	"path/filepath"
+	"sort"
+	"time"
+)
+
+func containerSpec(spec *proto.ContainerSpec) (*container.Config, *container.HostConfig, *network.NetworkingConfig, error) {
+	config := &container.Config{}
+	hostConfig := &container.HostConfig{}
+	networkConfig := &network.NetworkingConfig{}
+
+	if spec == nil {
+		return config, hostConfig, networkConfig, nil
+	}
+
+	envKeys := make([]string, 0, len(spec.Env))
+	for key := range spec.Env {
+		envKeys = append(envKeys, key)
+	}
+
+	sort.Strings(envKeys)
+	for _, key := range envKeys {
+		config.Env = append(config.Env, key+"="+spec.Env[key])
+	}
+
+	// the managed labels are added to this map, the request must not be modified
+	config.Labels = make(map[string]string, len(spec.Labels))
+	for key, value := range spec.Labels {
+		config.Labels[key] = value
+	}
+
+	config.Cmd = spec.Cmd
+	config.Entrypoint = spec.Entrypoint
+
+	if len(spec.Ports) != 0 {
+		config.ExposedPorts = nat.PortSet{}
+		hostConfig.PortBindings = nat.PortMap{}
+	}
+
+	for _, binding := range spec.Ports {
+		protocol := binding.Protocol
+		if protocol == "" {
+			protocol = "tcp"
+		}
+
+		port, err := nat.NewPort(protocol, binding.ContainerPort)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+
+		config.ExposedPorts[port] = struct{}{}
+		hostConfig.PortBindings[port] = append(hostConfig.PortBindings[port], nat.PortBinding{
+			HostIP:   binding.HostIp,
+			HostPort: binding.HostPort,
+		})
+	}
+
+	for _, m := range spec.Mounts {
+		mountType := mount.Type(m.Type)
+		if mountType == "" {
+			mountType = mount.TypeVolume
+			if filepath.IsAbs(m.Source) {
+				mountType = mount.TypeBind
+			}
+		}
+
+		hostConfig.Mounts = append(hostConfig.Mounts, mount.Mount{
+			Type:     mountType,
+			Source:   m.Source,
+			Target:   m.Target,
+			ReadOnly: m.ReadOnly,
+		})
+	}
+
+	if spec.RestartPolicy != nil {
+		hostConfig.RestartPolicy = restartPolicy(spec.RestartPolicy)
+	}
+
+	if spec.Resources != nil {
+		hostConfig.Resources = resources(spec.Resources)
+	}
+
+	if spec.Healthcheck != nil {
+		healthcheck, err := healthConfig(spec.Healthcheck)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+
+		config.Healthcheck = healthcheck
+	}
+
+	if spec.Network != "" {
+		hostConfig.NetworkMode = container.NetworkMode(spec.Network)
+		networkConfig.EndpointsConfig = map[string]*network.EndpointSettings{
+			spec.Network: {},
+		}
+	}
+
+	return config, hostConfig, networkConfig, nil
+}
+
+func restartPolicy(policy *proto.RestartPolicy) container.RestartPolicy {
+	return container.RestartPolicy{
+		Name:              policy.Name,
+		MaximumRetryCount: int(policy.MaximumRetryCount),
+	}
+}
+
+func resources(res *proto.Resources) container.Resources {
+	return container.Resources{
+		NanoCPUs:   int64(res.Cpus * 1e9),
+		CPUShares:  res.CpuShares,
+		Memory:     res.Memory,
+		MemorySwap: res.MemorySwap,
+	}
+}
+
+// healthConfig maps a healthcheck, an empty test inherits the one declared in the Dockerfile
+func healthConfig(check *proto.Healthcheck) (*container.HealthConfig, error) {
+	if check.Disable {
+		return &container.HealthConfig{
+			Test: []string{"NONE"},
+		}, nil
+	}
+
+	health := &container.HealthConfig{
+		Test:    check.Test,
+		Retries: int(check.Retries),
+	}
+
+	durations := []struct {
+		value  string
+		target *time.Duration
+	}{
+		{check.Interval, &health.Interval},
+		{check.Timeout, &health.Timeout},
+		{check.StartPeriod, &health.StartPeriod},
+	}
+
+	for _, duration := range durations {
+		if duration.value == "" {
+			continue
+		}
+
+		parsed, err := time.ParseDuration(duration.value)
+		if err != nil {
+			return nil, err
+		}
+
+		*duration.target = parsed
+	}
+
+	return health, nil
+}
@@ -0,0 +1,168 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"github.com/alpha-omega-corp/github-svc/pkg/services/docker/handlers"
+	proto "github.com/alpha-omega-corp/github-svc/proto/docker"
+	"github.com/google/go-github/v56/github"
+	"google.golang.org/protobuf/encoding/protojson"
+	"net/http"
+	"strings"
+)
+
+// stacks are stored next to the Dockerfile of the package version they belong to
+const stackFile = "stack.json"
+
+func (s *DockerServer) DeployStack(ctx context.Context, req *proto.DeployStackRequest) (*proto.DeployStackResponse, error) {
+	h, err := s.endpoints.Get(req.Endpoint)
+	if err != nil {
+		return nil, err
+	}
+
+	definition := req.Definition
+	if definition == nil {
+		definition, err = s.getStackDefinition(ctx, req.Path)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	services := make([]*handlers.StackService, len(definition.Services))
+	for index, service := range definition.Services {
+		// services always join the stack network
+		if service.Spec != nil && service.Spec.Network != "" {
+			return nil, errors.New("stack service " + service.Name + " cannot set a network, services join the stack network")
+		}
+
+		config, hostConfig, _, err := containerSpec(service.Spec)
+		if err != nil {
+			return nil, err
+		}
+
+		services[index] = &handlers.StackService{
+			Name:       service.Name,
+			Path:       service.Path,
+			DependsOn:  service.DependsOn,
+			Config:     config,
+			HostConfig: hostConfig,
+		}
+	}
+
+	// only valid definitions are stored
+	if err := handlers.ValidateStack(services); err != nil {
+		return nil, err
+	}
+
+	if req.Definition != nil {
+		if err := s.putStackDefinition(ctx, req.Path, definition); err != nil {
+			return nil, err
+		}
+	}
+
+	stack := stackName(req.Path)
+	if err := h.Stack().Deploy(ctx, stack, req.Owner, definition.Network, services); err != nil {
+		return nil, err
+	}
+
+	containers, err := h.Stack().Get(ctx, stack)
+	if err != nil {
+		return nil, err
+	}
+
+	resSlice := make([]*proto.Container, len(containers))
+	for index, c := range containers {
+		resSlice[index] = protoContainer(c)
+	}
+
+	return &proto.DeployStackResponse{
+		Status:     http.StatusCreated,
+		Containers: resSlice,
+	}, nil
+}
+
+func (s *DockerServer) GetStack(ctx context.Context, req *proto.GetStackRequest) (*proto.GetStackResponse, error) {
+	h, err := s.endpoints.Get(req.Endpoint)
+	if err != nil {
+		return nil, err
+	}
+
+	definition, err := s.getStackDefinition(ctx, req.Path)
+	if err != nil {
+		return nil, err
+	}
+
+	containers, err := h.Stack().Get(ctx, stackName(req.Path))
+	if err != nil {
+		return nil, err
+	}
+
+	resSlice := make([]*proto.Container, len(containers))
+	for index, c := range containers {
+		resSlice[index] = protoContainer(c)
+	}
+
+	return &proto.GetStackResponse{
+		Definition: definition,
+		Containers: resSlice,
+	}, nil
+}
+
+func (s *DockerServer) RemoveStack(ctx context.Context, req *proto.RemoveStackRequest) (*proto.RemoveStackResponse, error) {
+	h, err := s.endpoints.Get(req.Endpoint)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := h.Stack().Remove(ctx, stackName(req.Path)); err != nil {
+		return nil, err
+	}
+
+	return &proto.RemoveStackResponse{
+		Status: http.StatusOK,
+	}, nil
+}
+
+func (s *DockerServer) getStackDefinition(ctx context.Context, path string) (*proto.StackDefinition, error) {
+	content, err := s.repoHandler.GetContents(ctx, repository, path+"/"+stackFile)
+	if err != nil {
+		return nil, err
+	}
+
+	file, err := content.File.GetContent()
+	if err != nil {
+		return nil, err
+	}
+
+	definition := new(proto.StackDefinition)
+	if err := protojson.Unmarshal([]byte(file), definition); err != nil {
+		return nil, err
+	}
+
+	return definition, nil
+}
+
+func (s *DockerServer) putStackDefinition(ctx context.Context, path string, definition *proto.StackDefinition) error {
+	file, err := protojson.MarshalOptions{Multiline: true}.Marshal(definition)
+	if err != nil {
+		return err
+	}
+
+	// an existing definition is updated in place, which requires its sha
+	var sha *string
+	content, err := s.repoHandler.GetContents(ctx, repository, path+"/"+stackFile)
+	if err != nil {
+		var resErr *github.ErrorResponse
+		if !errors.As(err, &resErr) || resErr.Response.StatusCode != http.StatusNotFound {
+			return err
+		}
+	} else {
+		sha = content.File.SHA
+	}
+
+	return s.repoHandler.PutContents(ctx, repository, path+"/"+stackFile, file, sha)
+}
+
+func stackName(path string) string {
+	return strings.ReplaceAll(path, "/", "-")
+}
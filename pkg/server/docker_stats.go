@@ -0,0 +1,120 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"github.com/alpha-omega-corp/github-svc/pkg/services/docker"
+	pkgTypes "github.com/alpha-omega-corp/github-svc/pkg/types"
+	proto "github.com/alpha-omega-corp/github-svc/proto/docker"
+	"sync"
+	"time"
+)
+
+func (s *DockerServer) GetContainerStats(ctx context.Context, req *proto.GetContainerStatsRequest) (*proto.GetContainerStatsResponse, error) {
+	h, err := s.endpoints.Get(req.Endpoint)
+	if err != nil {
+		return nil, err
+	}
+
+	ids, err := statsTargets(ctx, h, req.ContainerId, req.Path)
+	if err != nil {
+		return nil, err
+	}
+
+	resSlice := make([]*proto.ContainerStats, len(ids))
+	for index, id := range ids {
+		stats, err := h.Container().Stats(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+
+		resSlice[index] = containerStats(stats)
+	}
+
+	return &proto.GetContainerStatsResponse{
+		Stats: resSlice,
+	}, nil
+}
+
+func (s *DockerServer) WatchContainerStats(req *proto.WatchContainerStatsRequest, stream proto.DockerService_WatchContainerStatsServer) error {
+	h, err := s.endpoints.Get(req.Endpoint)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithCancel(stream.Context())
+	defer cancel()
+
+	ids, err := statsTargets(ctx, h, req.ContainerId, req.Path)
+	if err != nil {
+		return err
+	}
+
+	// grpc streams do not support concurrent sends
+	var mu sync.Mutex
+	send := func(stats *pkgTypes.ContainerStats) error {
+		mu.Lock()
+		defer mu.Unlock()
+
+		return stream.Send(&proto.WatchContainerStatsResponse{
+			Stats: containerStats(stats),
+		})
+	}
+
+	errs := make(chan error, len(ids))
+	for _, id := range ids {
+		go func(id string) {
+			errs <- h.Container().WatchStats(ctx, id, send)
+		}(id)
+	}
+
+	for range ids {
+		if err := <-errs; err != nil && !errors.Is(err, context.Canceled) {
+			cancel()
+			return err
+		}
+	}
+
+	return nil
+}
+
+func statsTargets(ctx context.Context, h docker.Handler, cId string, path string) ([]string, error) {
+	if cId != "" {
+		return []string{cId}, nil
+	}
+
+	if path == "" {
+		return nil, errors.New("either a container id or a package path is required")
+	}
+
+	containers, err := h.Container().GetAllFrom(ctx, path)
+	if err != nil {
+		return nil, err
+	}
+
+	var ids []string
+	for _, c := range containers {
+		if c.State == "running" {
+			ids = append(ids, c.ID)
+		}
+	}
+
+	return ids, nil
+}
+
+func containerStats(stats *pkgTypes.ContainerStats) *proto.ContainerStats {
+	return &proto.ContainerStats{
+		ContainerId:   stats.Id,
+		Name:          stats.Name,
+		Read:          stats.Read.Format(time.RFC3339Nano),
+		CpuPercent:    stats.CpuPercent,
+		MemoryUsage:   stats.MemoryUsage,
+		MemoryLimit:   stats.MemoryLimit,
+		MemoryPercent: stats.MemoryPercent,
+		NetworkRx:     stats.NetworkRx,
+		NetworkTx:     stats.NetworkTx,
+		BlockRead:     stats.BlockRead,
+		BlockWrite:    stats.BlockWrite,
+		Pids:          stats.Pids,
+	}
+}
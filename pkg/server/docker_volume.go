@@ -0,0 +1,114 @@
+package server
+
+import (
+	"context"
+	"github.com/alpha-omega-corp/github-svc/pkg/services/docker/handlers"
+	proto "github.com/alpha-omega-corp/github-svc/proto/docker"
+	"github.com/docker/docker/api/types/filters"
+	"github.com/docker/docker/api/types/volume"
+	"net/http"
+	"strconv"
+)
+
+func (s *DockerServer) CreateVolume(ctx context.Context, req *proto.CreateVolumeRequest) (*proto.CreateVolumeResponse, error) {
+	h, err := s.endpoints.Get(req.Endpoint)
+	if err != nil {
+		return nil, err
+	}
+
+	v, err := h.Volume().Create(ctx, req.PackageName, volume.CreateOptions{
+		Name:       req.Name,
+		Driver:     req.Driver,
+		DriverOpts: req.Options,
+		Labels:     req.Labels,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &proto.CreateVolumeResponse{
+		Status: http.StatusCreated,
+		Volume: protoVolume(v),
+	}, nil
+}
+
+func (s *DockerServer) GetVolumes(ctx context.Context, req *proto.GetVolumesRequest) (*proto.GetVolumesResponse, error) {
+	h, err := s.endpoints.Get(req.Endpoint)
+	if err != nil {
+		return nil, err
+	}
+
+	filter := filters.NewArgs()
+	if req.PackageName != "" {
+		filter.Add("label", handlers.LabelPackage+"="+req.PackageName)
+	}
+
+	for _, name := range req.Names {
+		filter.Add("name", name)
+	}
+
+	for _, label := range req.Labels {
+		filter.Add("label", label)
+	}
+
+	if req.Dangling {
+		filter.Add("dangling", strconv.FormatBool(req.Dangling))
+	}
+
+	volumes, err := h.Volume().GetAll(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	resSlice := make([]*proto.Volume, len(volumes))
+	for index, v := range volumes {
+		resSlice[index] = protoVolume(v)
+	}
+
+	return &proto.GetVolumesResponse{
+		Volumes: resSlice,
+	}, nil
+}
+
+func (s *DockerServer) InspectVolume(ctx context.Context, req *proto.InspectVolumeRequest) (*proto.InspectVolumeResponse, error) {
+	h, err := s.endpoints.Get(req.Endpoint)
+	if err != nil {
+		return nil, err
+	}
+
+	v, err := h.Volume().Inspect(ctx, req.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	return &proto.InspectVolumeResponse{
+		Volume: protoVolume(v),
+	}, nil
+}
+
+func (s *DockerServer) DeleteVolume(ctx context.Context, req *proto.DeleteVolumeRequest) (*proto.DeleteVolumeResponse, error) {
+	h, err := s.endpoints.Get(req.Endpoint)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := h.Volume().Delete(ctx, req.Name, req.Force); err != nil {
+		return nil, err
+	}
+
+	return &proto.DeleteVolumeResponse{
+		Status: http.StatusOK,
+	}, nil
+}
+
+func protoVolume(v *volume.Volume) *proto.Volume {
+	return &proto.Volume{
+		Name:       v.Name,
+		Driver:     v.Driver,
+		Mountpoint: v.Mountpoint,
+		Scope:      v.Scope,
+		Created:    v.CreatedAt,
+		Labels:     v.Labels,
+		Options:    v.Options,
+	}
+}
@@ -4,13 +4,18 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"github.com/alpha-omega-corp/github-svc/pkg/services/docker"
+	"github.com/alpha-omega-corp/github-svc/pkg/services/docker/handlers"
 	"github.com/alpha-omega-corp/github-svc/pkg/services/github"
+	gitHandlers "github.com/alpha-omega-corp/github-svc/pkg/services/github/handlers"
+	"github.com/alpha-omega-corp/github-svc/pkg/services/job"
 	pkgTypes "github.com/alpha-omega-corp/github-svc/pkg/types"
 	proto "github.com/alpha-omega-corp/github-svc/proto/github"
-	"github.com/alpha-omega-corp/services/types"
+	dockerTypes "github.com/docker/docker/api/types"
+	"github.com/docker/docker/pkg/jsonmessage"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"net/http"
-	"os"
-	"path/filepath"
 	"strings"
 )
 
@@ -18,47 +23,76 @@ type GithubServer struct {
 	proto.UnimplementedGithubServiceServer
 
 	handler github.Handler
+	engines docker.EndpointHandler
+	jobs    job.Handler
 }
 
-func NewGithubServer(env *types.Environment) *GithubServer {
+func NewGithubServer(handler github.Handler, engines docker.EndpointHandler, jobs job.Handler) *GithubServer {
 	return &GithubServer{
-		handler: github.NewHandler(env.Config),
+		handler: handler,
+		engines: engines,
+		jobs:    jobs,
 	}
 }
 
 func (s *GithubServer) GetSecretContent(ctx context.Context, req *proto.GetSecretContentRequest) (*proto.GetSecretContentResponse, error) {
-	res, err := s.handler.Exec().KvsGet(ctx, strings.ToLower(req.Name))
+	scope, err := secretScope(req.Scope)
 	if err != nil {
 		return nil, err
 	}
 
+	res, err := s.handler.Exec().KvsGet(ctx, gitHandlers.SecretKey(scope, req.Name))
+	if err != nil {
+		return nil, err
+	}
+
+	if len(res.Kvs) == 0 {
+		return nil, status.Error(codes.NotFound, "no content stored for secret "+req.Name)
+	}
+
 	return &proto.GetSecretContentResponse{
 		Content: res.Kvs[0].Value,
 	}, nil
 }
 
 func (s *GithubServer) SyncEnvironment(ctx context.Context, req *proto.SyncEnvironmentRequest) (*proto.SyncEnvironmentResponse, error) {
-	secrets, err := s.handler.Secrets().GetAll(ctx)
-	if err != nil {
-		return nil, err
+	scopes := []pkgTypes.SecretScope{{Type: gitHandlers.ScopeOrg, Store: gitHandlers.StoreActions}}
+	if req.Repository != "" {
+		scopes = append(scopes, pkgTypes.SecretScope{Type: gitHandlers.ScopeRepository, Repository: req.Repository, Store: gitHandlers.StoreActions})
 	}
 
+	// later scopes are more specific, as on github their values win
 	env := make(map[string]string)
-	for _, secret := range secrets {
-		key := strings.ToLower(secret.Name)
-		res, err := s.handler.Exec().KvsGet(ctx, key)
+	vars := make(map[string]string)
+	for _, scope := range scopes {
+		secrets, err := s.handler.Secrets().GetAll(ctx, scope)
 		if err != nil {
 			return nil, err
 		}
 
-		if len(res.Kvs) != 0 {
-			contentString := string(res.Kvs[0].Value)
-			inline := strings.Replace(contentString, "\n", "", -1)
-			inline = strings.Replace(inline, ",}", " }, ", -1)
+		for _, secret := range secrets {
+			res, err := s.handler.Exec().KvsGet(ctx, gitHandlers.SecretKey(scope, secret.Name))
+			if err != nil {
+				return nil, err
+			}
+
+			if len(res.Kvs) != 0 {
+				contentString := string(res.Kvs[0].Value)
+				inline := strings.Replace(contentString, "\n", "", -1)
+				inline = strings.Replace(inline, ",}", " }, ", -1)
+
+				env[secret.Name] = inline
+			}
+		}
 
-			env[secret.Name] = inline
+		variables, err := s.handler.Variables().GetAll(ctx, scope)
+		if err != nil {
+			return nil, err
 		}
 
+		for _, variable := range variables {
+			vars[variable.Name] = strings.Replace(variable.Value, "\n", "", -1)
+		}
 	}
 
 	buf, err := s.handler.Templates().CreateConfiguration(env)
@@ -70,13 +104,27 @@ func (s *GithubServer) SyncEnvironment(ctx context.Context, req *proto.SyncEnvir
 		return nil, err
 	}
 
+	varsBuf, err := s.handler.Templates().CreateConfiguration(vars)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.handler.Exec().WriteVariables(varsBuf); err != nil {
+		return nil, err
+	}
+
 	return &proto.SyncEnvironmentResponse{
 		Status: http.StatusOK,
 	}, nil
 }
 
 func (s *GithubServer) GetSecrets(ctx context.Context, req *proto.GetSecretsRequest) (*proto.GetSecretsResponse, error) {
-	secrets, err := s.handler.Secrets().GetAll(ctx)
+	scope, err := secretScope(req.Scope)
+	if err != nil {
+		return nil, err
+	}
+
+	secrets, err := s.handler.Secrets().GetAll(ctx, scope)
 	if err != nil {
 		return nil, err
 	}
@@ -89,6 +137,13 @@ func (s *GithubServer) GetSecrets(ctx context.Context, req *proto.GetSecretsRequ
 			UpdatedAt:  secret.UpdatedAt.String(),
 			Visibility: secret.Visibility,
 		}
+
+		if secret.Visibility == gitHandlers.VisibilitySelected {
+			resSlice[index].Repositories, err = s.handler.Secrets().GetRepositories(ctx, scope.Store, secret.Name)
+			if err != nil {
+				return nil, err
+			}
+		}
 	}
 
 	return &proto.GetSecretsResponse{
@@ -97,11 +152,28 @@ func (s *GithubServer) GetSecrets(ctx context.Context, req *proto.GetSecretsRequ
 }
 
 func (s *GithubServer) CreateSecret(ctx context.Context, req *proto.CreateSecretRequest) (*proto.CreateSecretResponse, error) {
-	if err := s.handler.Exec().KvsPut(ctx, req.Name, string(req.Content)); err != nil {
+	scope, err := secretScope(req.Scope)
+	if err != nil {
+		return nil, err
+	}
+
+	visibility, err := secretVisibility(scope, req.Visibility, req.Repositories)
+	if err != nil {
+		return nil, err
+	}
+
+	// the mirror and the history only follow what github accepted
+	applied, err := s.handler.Secrets().Create(ctx, scope, req.Name, req.Content, visibility)
+	if err != nil {
 		return nil, err
 	}
 
-	if err := s.handler.Secrets().Create(ctx, req.Name, req.Content); err != nil {
+	key := gitHandlers.SecretKey(scope, req.Name)
+	if err := s.handler.Exec().KvsPut(ctx, key, string(req.Content)); err != nil {
+		return nil, err
+	}
+
+	if _, err := s.handler.History().Record(ctx, key, historyVersion(gitHandlers.SecretCreated, req.Content, applied)); err != nil {
 		return nil, err
 	}
 
@@ -111,11 +183,24 @@ func (s *GithubServer) CreateSecret(ctx context.Context, req *proto.CreateSecret
 }
 
 func (s *GithubServer) DeleteSecret(ctx context.Context, req *proto.DeleteSecretRequest) (*proto.DeleteSecretResponse, error) {
-	if err := s.handler.Exec().KvsDelete(ctx, strings.ToLower(req.Name)); err != nil {
+	scope, err := secretScope(req.Scope)
+	if err != nil {
 		return nil, err
 	}
 
-	if err := s.handler.Secrets().Delete(ctx, req.Name); err != nil {
+	if err := s.handler.Secrets().Delete(ctx, scope, req.Name); err != nil {
+		return nil, err
+	}
+
+	key := gitHandlers.SecretKey(scope, req.Name)
+	if err := s.handler.Exec().KvsDelete(ctx, key); err != nil {
+		return nil, err
+	}
+
+	// the history is kept so that a deleted secret can be restored
+	if _, err := s.handler.History().Record(ctx, key, &pkgTypes.SecretVersion{
+		Action: gitHandlers.SecretDeleted,
+	}); err != nil {
 		return nil, err
 	}
 
@@ -141,50 +226,83 @@ func (s *GithubServer) GetPackageTags(ctx context.Context, req *proto.GetPackage
 }
 
 func (s *GithubServer) PushPackage(ctx context.Context, req *proto.PushPackageRequest) (*proto.PushPackageResponse, error) {
-	buf, err := s.handler.Templates().CreateMakefile(req.Name, req.Tag)
+	h, err := s.engines.Get(req.Endpoint)
 	if err != nil {
 		return nil, err
 	}
 
-	path := req.Name + "/" + req.Tag + "/Dockerfile"
-	content, err := s.handler.Repositories().GetContents(ctx, repository, path)
-	if err != nil {
-		return nil, err
-	}
+	path := req.Name + "/" + req.Tag
+	j, err := s.jobs.Start("push-package", func(ctx context.Context, reporter job.Reporter) error {
+		reporter.Progress(0, "fetching the build context of "+path)
 
-	dockerfile, cErr := content.File.GetContent()
-	if err != nil {
-		return nil, cErr
-	}
+		files, err := s.handler.Repositories().GetPackageFiles(ctx, path)
+		if err != nil {
+			return err
+		}
 
-	dir, err := os.MkdirTemp("/tmp", req.VersionSHA)
-	if err != nil {
-		return nil, err
-	}
+		buildContext, err := handlers.BuildContext(contextFiles(files))
+		if err != nil {
+			return err
+		}
+
+		reporter.Progress(0.1, "building "+path)
+
+		imgName := h.Image().Name(path)
+		if err := h.Image().Build(ctx, buildContext, dockerTypes.ImageBuildOptions{
+			Tags:   []string{imgName},
+			Remove: true,
+		}, func(msg *jsonmessage.JSONMessage) error {
+			if line := strings.TrimSuffix(msg.Stream, "\n"); line != "" {
+				reporter.Log(line)
+			}
 
-	defer func(path string) {
-		rmErr := os.RemoveAll(path)
-		if rmErr != nil {
-			panic(rmErr)
+			return nil
+		}); err != nil {
+			return err
 		}
-	}(dir)
 
-	tmpMakefile := filepath.Join(dir, "Makefile")
-	if err := os.WriteFile(tmpMakefile, buf.Bytes(), 0644); err != nil {
-		return nil, err
-	}
+		reporter.Progress(0.6, "pushing "+imgName)
 
-	tmpDockerfile := filepath.Join(dir, "Dockerfile")
-	if err := os.WriteFile(tmpDockerfile, []byte(dockerfile), 0644); err != nil {
-		return nil, err
-	}
+		digest, err := h.Image().Push(ctx, imgName, func(msg *jsonmessage.JSONMessage) error {
+			// per layer byte counts are too chatty to be kept in the job logs
+			if msg.Progress == nil && msg.Status != "" {
+				reporter.Log(strings.TrimSpace(msg.ID + " " + msg.Status))
+			}
+
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+
+		reporter.Result(pkgTypes.JobResult{
+			Push: &pkgTypes.PushResult{Digest: digest},
+		})
+		reporter.Progress(0.9, "resolving the package version of "+digest)
 
-	if err := s.handler.Packages().Push(dir); err != nil {
+		// ghcr names container package versions after their manifest digest
+		versions, err := s.handler.Packages().GetVersions(req.Name)
+		if err != nil {
+			return err
+		}
+
+		for _, version := range versions {
+			if version.Name == digest {
+				reporter.Result(pkgTypes.JobResult{
+					Push: &pkgTypes.PushResult{Digest: digest, VersionId: version.Id},
+				})
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
 		return nil, err
 	}
 
 	return &proto.PushPackageResponse{
-		Status: http.StatusCreated,
+		Status: http.StatusAccepted,
+		JobId:  j.Id,
 	}, nil
 }
 
@@ -201,37 +319,43 @@ func (s *GithubServer) CreatePackage(ctx context.Context, req *proto.CreatePacka
 }
 
 func (s *GithubServer) DeletePackage(ctx context.Context, req *proto.DeletePackageRequest) (*proto.DeletePackageResponse, error) {
-	content, err := s.handler.Repositories().GetContents(ctx, repository, req.Name)
-	if err != nil {
-		return nil, err
-	}
+	j, err := s.jobs.Start("delete-package", func(ctx context.Context, reporter job.Reporter) error {
+		content, err := s.handler.Repositories().GetContents(ctx, repository, req.Name)
+		if err != nil {
+			return err
+		}
 
-	for _, item := range content.Dir {
-		if len(content.Dir) == 1 {
-			if err := s.handler.Repositories().DeleteContents(ctx, repository, req.Name+"/.gitkeep", *item.SHA); err != nil {
-				return nil, err
+		for index, item := range content.Dir {
+			if len(content.Dir) == 1 {
+				return s.handler.Repositories().DeleteContents(ctx, repository, req.Name+"/.gitkeep", *item.SHA)
 			}
 
-			return &proto.DeletePackageResponse{
-				Status: http.StatusOK,
-			}, nil
-		}
+			path := req.Name + "/" + *item.Name
+			reporter.Progress(float64(index)/float64(len(content.Dir)), "deleting "+path)
 
-		path := req.Name + "/" + *item.Name
-		files, err := s.handler.Repositories().GetPackageFiles(ctx, path)
-		if err != nil {
-			return nil, err
-		}
+			files, err := s.handler.Repositories().GetPackageFiles(ctx, path)
+			if err != nil {
+				return err
+			}
 
-		for _, file := range files {
-			if err := s.handler.Repositories().DeleteContents(ctx, repository, path+"/"+file.Name, file.SHA); err != nil {
-				return nil, err
+			for _, file := range files {
+				if err := s.handler.Repositories().DeleteContents(ctx, repository, path+"/"+file.Name, file.SHA); err != nil {
+					return err
+				}
+
+				reporter.Log("deleted " + path + "/" + file.Name)
 			}
 		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
 	}
 
 	return &proto.DeletePackageResponse{
-		Status: http.StatusOK,
+		Status: http.StatusAccepted,
+		JobId:  j.Id,
 	}, nil
 }
 
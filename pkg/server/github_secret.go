@@ -0,0 +1,194 @@
+package server
+
+import (
+	"context"
+	"errors"
+	gitHandlers "github.com/alpha-omega-corp/github-svc/pkg/services/github/handlers"
+	pkgTypes "github.com/alpha-omega-corp/github-svc/pkg/types"
+	proto "github.com/alpha-omega-corp/github-svc/proto/github"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+func (s *GithubServer) GetSecretHistory(ctx context.Context, req *proto.GetSecretHistoryRequest) (*proto.GetSecretHistoryResponse, error) {
+	scope, err := secretScope(req.Scope)
+	if err != nil {
+		return nil, err
+	}
+
+	versions, err := s.handler.History().GetAll(ctx, gitHandlers.SecretKey(scope, req.Name))
+	if err != nil {
+		return nil, err
+	}
+
+	resSlice := make([]*proto.SecretVersion, len(versions))
+	for index, version := range versions {
+		resSlice[index] = &proto.SecretVersion{
+			Version:        version.Version,
+			Action:         version.Action,
+			RolledBackFrom: version.RolledBackFrom,
+			Visibility:     version.Visibility,
+			Repositories:   version.Repositories,
+			Created:        version.Created.Format(time.RFC3339),
+		}
+
+		if req.WithContent {
+			resSlice[index].Content = version.Content
+		}
+	}
+
+	return &proto.GetSecretHistoryResponse{
+		Versions: resSlice,
+	}, nil
+}
+
+func (s *GithubServer) RollbackSecret(ctx context.Context, req *proto.RollbackSecretRequest) (*proto.RollbackSecretResponse, error) {
+	scope, err := secretScope(req.Scope)
+	if err != nil {
+		return nil, err
+	}
+
+	key := gitHandlers.SecretKey(scope, req.Name)
+	version, err := s.handler.History().Get(ctx, key, req.Version)
+	if err != nil {
+		return nil, err
+	}
+
+	if version.Action == gitHandlers.SecretDeleted {
+		return nil, errors.New("version " + strconv.FormatInt(req.Version, 10) + " of secret " + req.Name + " is a deletion")
+	}
+
+	// a deleted secret has no visibility left to keep, it comes back with the one it was recorded with
+	var visibility *pkgTypes.SecretVisibility
+	if version.Visibility != "" {
+		visibility = &pkgTypes.SecretVisibility{
+			Visibility:   version.Visibility,
+			Repositories: version.Repositories,
+		}
+	}
+
+	applied, err := s.handler.Secrets().Create(ctx, scope, req.Name, version.Content, visibility)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.handler.Exec().KvsPut(ctx, key, string(version.Content)); err != nil {
+		return nil, err
+	}
+
+	rollback := historyVersion(gitHandlers.SecretRolledBack, version.Content, applied)
+	rollback.RolledBackFrom = version.Version
+
+	recorded, err := s.handler.History().Record(ctx, key, rollback)
+	if err != nil {
+		return nil, err
+	}
+
+	return &proto.RollbackSecretResponse{
+		Status:  http.StatusOK,
+		Version: recorded.Version,
+	}, nil
+}
+
+func secretScope(scope *proto.SecretScope) (pkgTypes.SecretScope, error) {
+	res := pkgTypes.SecretScope{
+		Type:  gitHandlers.ScopeOrg,
+		Store: gitHandlers.StoreActions,
+	}
+
+	if scope == nil {
+		return res, nil
+	}
+
+	switch scope.Type {
+	case proto.ScopeType_REPOSITORY:
+		res.Type = gitHandlers.ScopeRepository
+	case proto.ScopeType_ENVIRONMENT:
+		res.Type = gitHandlers.ScopeEnvironment
+	}
+
+	res.Store = secretStore(scope.Store)
+	res.Repository = scope.Repository
+	res.Environment = scope.Environment
+
+	return res, gitHandlers.ValidateScope(res)
+}
+
+func (s *GithubServer) GetSecretRepositories(ctx context.Context, req *proto.GetSecretRepositoriesRequest) (*proto.GetSecretRepositoriesResponse, error) {
+	repos, err := s.handler.Secrets().GetRepositories(ctx, secretStore(req.Store), req.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	return &proto.GetSecretRepositoriesResponse{
+		Repositories: repos,
+	}, nil
+}
+
+func (s *GithubServer) AddSecretRepository(ctx context.Context, req *proto.AddSecretRepositoryRequest) (*proto.AddSecretRepositoryResponse, error) {
+	if err := s.handler.Secrets().AddRepository(ctx, secretStore(req.Store), req.Name, req.Repository); err != nil {
+		return nil, err
+	}
+
+	return &proto.AddSecretRepositoryResponse{
+		Status: http.StatusCreated,
+	}, nil
+}
+
+func (s *GithubServer) RemoveSecretRepository(ctx context.Context, req *proto.RemoveSecretRepositoryRequest) (*proto.RemoveSecretRepositoryResponse, error) {
+	if err := s.handler.Secrets().RemoveRepository(ctx, secretStore(req.Store), req.Name, req.Repository); err != nil {
+		return nil, err
+	}
+
+	return &proto.RemoveSecretRepositoryResponse{
+		Status: http.StatusOK,
+	}, nil
+}
+
+func historyVersion(action string, content []byte, visibility *pkgTypes.SecretVisibility) *pkgTypes.SecretVersion {
+	version := &pkgTypes.SecretVersion{
+		Action:  action,
+		Content: content,
+	}
+
+	if visibility != nil {
+		version.Visibility = visibility.Visibility
+		version.Repositories = visibility.Repositories
+	}
+
+	return version
+}
+
+func secretStore(store proto.SecretStore) string {
+	switch store {
+	case proto.SecretStore_DEPENDABOT:
+		return gitHandlers.StoreDependabot
+	case proto.SecretStore_CODESPACES:
+		return gitHandlers.StoreCodespaces
+	default:
+		return gitHandlers.StoreActions
+	}
+}
+
+// secretVisibility keeps the visibility of an existing org secret when the request does not set one
+func secretVisibility(scope pkgTypes.SecretScope, visibility string, repos []string) (*pkgTypes.SecretVisibility, error) {
+	if visibility == "" && len(repos) == 0 {
+		return nil, nil
+	}
+
+	if scope.Type != gitHandlers.ScopeOrg {
+		return nil, errors.New("only org secrets and variables have a visibility")
+	}
+
+	res := &pkgTypes.SecretVisibility{
+		Visibility:   visibility,
+		Repositories: repos,
+	}
+
+	if res.Visibility == "" {
+		res.Visibility = gitHandlers.VisibilitySelected
+	}
+
+	return res, gitHandlers.ValidateVisibility(res)
+}
@@ -0,0 +1,153 @@
+package server
+
+import (
+	gitHandlers "github.com/alpha-omega-corp/github-svc/pkg/services/github/handlers"
+	pkgTypes "github.com/alpha-omega-corp/github-svc/pkg/types"
+	proto "github.com/alpha-omega-corp/github-svc/proto/github"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestSecretScope(t *testing.T) {
+	tests := []struct {
+		name  string
+		scope *proto.SecretScope
+		want  pkgTypes.SecretScope
+		err   string
+	}{
+		{
+			name:  "missing scope defaults to org actions",
+			scope: nil,
+			want:  pkgTypes.SecretScope{Type: gitHandlers.ScopeOrg, Store: gitHandlers.StoreActions},
+		},
+		{
+			name:  "org",
+			scope: &proto.SecretScope{Type: proto.ScopeType_ORG},
+			want:  pkgTypes.SecretScope{Type: gitHandlers.ScopeOrg, Store: gitHandlers.StoreActions},
+		},
+		{
+			name:  "repository",
+			scope: &proto.SecretScope{Type: proto.ScopeType_REPOSITORY, Repository: "api"},
+			want:  pkgTypes.SecretScope{Type: gitHandlers.ScopeRepository, Repository: "api", Store: gitHandlers.StoreActions},
+		},
+		{
+			name:  "environment",
+			scope: &proto.SecretScope{Type: proto.ScopeType_ENVIRONMENT, Repository: "api", Environment: "prod"},
+			want:  pkgTypes.SecretScope{Type: gitHandlers.ScopeEnvironment, Repository: "api", Environment: "prod", Store: gitHandlers.StoreActions},
+		},
+		{
+			name:  "dependabot",
+			scope: &proto.SecretScope{Type: proto.ScopeType_ORG, Store: proto.SecretStore_DEPENDABOT},
+			want:  pkgTypes.SecretScope{Type: gitHandlers.ScopeOrg, Store: gitHandlers.StoreDependabot},
+		},
+		{
+			name:  "environment without a repository",
+			scope: &proto.SecretScope{Type: proto.ScopeType_ENVIRONMENT, Environment: "prod"},
+			err:   "require a repository and an environment",
+		},
+		{
+			name:  "codespaces outside of the org",
+			scope: &proto.SecretScope{Type: proto.ScopeType_REPOSITORY, Repository: "api", Store: proto.SecretStore_CODESPACES},
+			err:   "only be managed at the org level",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := secretScope(tt.scope)
+			if tt.err != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.err) {
+					t.Fatalf("expected an error containing %q, got %v", tt.err, err)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if got != tt.want {
+				t.Fatalf("expected %+v, got %+v", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestSecretVisibility(t *testing.T) {
+	org := pkgTypes.SecretScope{Type: gitHandlers.ScopeOrg, Store: gitHandlers.StoreActions}
+	repo := pkgTypes.SecretScope{Type: gitHandlers.ScopeRepository, Repository: "api", Store: gitHandlers.StoreActions}
+
+	tests := []struct {
+		name       string
+		scope      pkgTypes.SecretScope
+		visibility string
+		repos      []string
+		want       *pkgTypes.SecretVisibility
+		err        string
+	}{
+		{
+			name:  "unset keeps the current visibility",
+			scope: org,
+			want:  nil,
+		},
+		{
+			name:       "all",
+			scope:      org,
+			visibility: gitHandlers.VisibilityAll,
+			want:       &pkgTypes.SecretVisibility{Visibility: gitHandlers.VisibilityAll},
+		},
+		{
+			name:  "repositories default to selected",
+			scope: org,
+			repos: []string{"api", "web"},
+			want:  &pkgTypes.SecretVisibility{Visibility: gitHandlers.VisibilitySelected, Repositories: []string{"api", "web"}},
+		},
+		{
+			name:       "repositories with another visibility",
+			scope:      org,
+			visibility: gitHandlers.VisibilityPrivate,
+			repos:      []string{"api"},
+			err:        "only be selected with the selected visibility",
+		},
+		{
+			name:       "unknown visibility",
+			scope:      org,
+			visibility: "public",
+			err:        "unknown secret visibility",
+		},
+		{
+			name:       "repository secrets have no visibility",
+			scope:      repo,
+			visibility: gitHandlers.VisibilityAll,
+			err:        "only org secrets and variables have a visibility",
+		},
+		{
+			name:  "repository secrets without a visibility",
+			scope: repo,
+			want:  nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := secretVisibility(tt.scope, tt.visibility, tt.repos)
+			if tt.err != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.err) {
+					t.Fatalf("expected an error containing %q, got %v", tt.err, err)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Fatalf("expected %+v, got %+v", tt.want, got)
+			}
+		})
+	}
+}
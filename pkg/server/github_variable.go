@@ -0,0 +1,121 @@
+package server
+
+import (
+	"context"
+	gitHandlers "github.com/alpha-omega-corp/github-svc/pkg/services/github/handlers"
+	pkgTypes "github.com/alpha-omega-corp/github-svc/pkg/types"
+	proto "github.com/alpha-omega-corp/github-svc/proto/github"
+	"github.com/google/go-github/v56/github"
+	"net/http"
+)
+
+func (s *GithubServer) GetVariables(ctx context.Context, req *proto.GetVariablesRequest) (*proto.GetVariablesResponse, error) {
+	scope, err := variableScope(req.Scope)
+	if err != nil {
+		return nil, err
+	}
+
+	variables, err := s.handler.Variables().GetAll(ctx, scope)
+	if err != nil {
+		return nil, err
+	}
+
+	resSlice := make([]*proto.Variable, len(variables))
+	for index, variable := range variables {
+		resSlice[index] = protoVariable(variable)
+	}
+
+	return &proto.GetVariablesResponse{
+		Variables: resSlice,
+	}, nil
+}
+
+func (s *GithubServer) GetVariable(ctx context.Context, req *proto.GetVariableRequest) (*proto.GetVariableResponse, error) {
+	scope, err := variableScope(req.Scope)
+	if err != nil {
+		return nil, err
+	}
+
+	variable, err := s.handler.Variables().Get(ctx, scope, req.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	return &proto.GetVariableResponse{
+		Variable: protoVariable(variable),
+	}, nil
+}
+
+func (s *GithubServer) CreateVariable(ctx context.Context, req *proto.CreateVariableRequest) (*proto.CreateVariableResponse, error) {
+	scope, err := variableScope(req.Scope)
+	if err != nil {
+		return nil, err
+	}
+
+	visibility, err := secretVisibility(scope, req.Visibility, req.Repositories)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.handler.Variables().Create(ctx, scope, req.Name, req.Value, visibility); err != nil {
+		return nil, err
+	}
+
+	return &proto.CreateVariableResponse{
+		Status: http.StatusCreated,
+	}, nil
+}
+
+func (s *GithubServer) UpdateVariable(ctx context.Context, req *proto.UpdateVariableRequest) (*proto.UpdateVariableResponse, error) {
+	scope, err := variableScope(req.Scope)
+	if err != nil {
+		return nil, err
+	}
+
+	visibility, err := secretVisibility(scope, req.Visibility, req.Repositories)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.handler.Variables().Update(ctx, scope, req.Name, req.Value, visibility); err != nil {
+		return nil, err
+	}
+
+	return &proto.UpdateVariableResponse{
+		Status: http.StatusOK,
+	}, nil
+}
+
+func (s *GithubServer) DeleteVariable(ctx context.Context, req *proto.DeleteVariableRequest) (*proto.DeleteVariableResponse, error) {
+	scope, err := variableScope(req.Scope)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.handler.Variables().Delete(ctx, scope, req.Name); err != nil {
+		return nil, err
+	}
+
+	return &proto.DeleteVariableResponse{
+		Status: http.StatusOK,
+	}, nil
+}
+
+func variableScope(scope *proto.SecretScope) (pkgTypes.SecretScope, error) {
+	res, err := secretScope(scope)
+	if err != nil {
+		return res, err
+	}
+
+	return res, gitHandlers.ValidateVariableScope(res)
+}
+
+func protoVariable(variable *github.ActionsVariable) *proto.Variable {
+	return &proto.Variable{
+		Name:       variable.Name,
+		Value:      variable.Value,
+		CreatedAt:  variable.GetCreatedAt().String(),
+		UpdatedAt:  variable.GetUpdatedAt().String(),
+		Visibility: variable.GetVisibility(),
+	}
+}
@@ -0,0 +1,109 @@
+package server
+
+import (
+	"context"
+	"github.com/alpha-omega-corp/github-svc/pkg/services/job"
+	pkgTypes "github.com/alpha-omega-corp/github-svc/pkg/types"
+	proto "github.com/alpha-omega-corp/github-svc/proto/job"
+	"net/http"
+	"time"
+)
+
+type JobServer struct {
+	proto.UnimplementedJobServiceServer
+
+	handler job.Handler
+}
+
+func NewJobServer(jobs job.Handler) *JobServer {
+	return &JobServer{
+		handler: jobs,
+	}
+}
+
+func (s *JobServer) GetJob(ctx context.Context, req *proto.GetJobRequest) (*proto.GetJobResponse, error) {
+	res, err := s.handler.Get(req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	return &proto.GetJobResponse{
+		Job: protoJob(res),
+	}, nil
+}
+
+func (s *JobServer) ListJobs(ctx context.Context, req *proto.ListJobsRequest) (*proto.ListJobsResponse, error) {
+	res := s.handler.GetAll(req.Type, req.State)
+
+	resSlice := make([]*proto.Job, len(res))
+	for index, j := range res {
+		resSlice[index] = protoJob(j)
+	}
+
+	return &proto.ListJobsResponse{
+		Jobs: resSlice,
+	}, nil
+}
+
+func (s *JobServer) CancelJob(ctx context.Context, req *proto.CancelJobRequest) (*proto.CancelJobResponse, error) {
+	if err := s.handler.Cancel(req.Id); err != nil {
+		return nil, err
+	}
+
+	return &proto.CancelJobResponse{
+		Status: http.StatusOK,
+	}, nil
+}
+
+func (s *JobServer) WatchJob(req *proto.WatchJobRequest, stream proto.JobService_WatchJobServer) error {
+	return s.handler.Watch(stream.Context(), req.Id, func(j *pkgTypes.Job) error {
+		return stream.Send(&proto.WatchJobResponse{
+			Job: protoJob(j),
+		})
+	})
+}
+
+func protoJob(j *pkgTypes.Job) *proto.Job {
+	res := &proto.Job{
+		Id:       j.Id,
+		Type:     j.Type,
+		State:    j.State,
+		Progress: j.Progress,
+		Message:  j.Message,
+		Logs:     j.Logs,
+		Error:    j.Error,
+		Created:  j.Created.Format(time.RFC3339),
+		Updated:  j.Updated.Format(time.RFC3339),
+	}
+
+	switch {
+	case j.Result == nil:
+	case j.Result.Container != nil:
+		res.Result = &proto.Job_Container{Container: protoContainerResult(j.Result.Container)}
+	case j.Result.Push != nil:
+		res.Result = &proto.Job_Push{Push: &proto.PushResult{
+			Digest:    j.Result.Push.Digest,
+			VersionId: j.Result.Push.VersionId,
+		}}
+	}
+
+	return res
+}
+
+func protoContainerResult(result *pkgTypes.ContainerResult) *proto.ContainerResult {
+	res := &proto.ContainerResult{
+		ContainerId: result.ContainerId,
+	}
+
+	if health := result.Health; health != nil {
+		res.Health = &proto.ContainerHealth{
+			State:         health.State,
+			Status:        health.Status,
+			FailingStreak: int64(health.FailingStreak),
+			Output:        health.Output,
+			ExitCode:      int64(health.ExitCode),
+		}
+	}
+
+	return res
+}
@@ -0,0 +1,168 @@
+package docker
+
+import (
+	"context"
+	"errors"
+	pkgTypes "github.com/alpha-omega-corp/github-svc/pkg/types"
+	"github.com/alpha-omega-corp/services/types"
+	"github.com/docker/docker/client"
+	"strings"
+	"time"
+)
+
+// DefaultEndpoint names the engine built from the environment when no endpoints are configured
+const DefaultEndpoint = "local"
+
+const defaultHealthInterval = 30 * time.Second
+
+type EndpointHandler interface {
+	Get(name string) (Handler, error)
+	GetAll() []pkgTypes.DockerEndpoint
+	Default() string
+	Status(name string) (pkgTypes.EngineStatus, error)
+	Close() error
+}
+
+type endpointHandler struct {
+	EndpointHandler
+
+	endpoints []pkgTypes.DockerEndpoint
+	engines   map[string]*engine
+	cancel    context.CancelFunc
+	done      chan struct{}
+}
+
+func NewEndpointHandler(c types.Config) EndpointHandler {
+	var endpoints []pkgTypes.DockerEndpoint
+	if err := c.Viper.UnmarshalKey("endpoints", &endpoints); err != nil {
+		panic(err)
+	}
+
+	if len(endpoints) == 0 {
+		endpoints = []pkgTypes.DockerEndpoint{{Name: DefaultEndpoint}}
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	h := &endpointHandler{
+		endpoints: endpoints,
+		engines:   make(map[string]*engine, len(endpoints)),
+		cancel:    cancel,
+		done:      make(chan struct{}),
+	}
+
+	for _, endpoint := range endpoints {
+		if endpoint.Name == "" {
+			panic(errors.New("docker endpoints require a name"))
+		}
+
+		if _, exists := h.engines[endpoint.Name]; exists {
+			panic(errors.New("duplicate docker endpoint " + endpoint.Name))
+		}
+
+		h.engines[endpoint.Name] = newEngine(endpoint, c)
+	}
+
+	interval := c.Viper.GetDuration("healthInterval")
+	if interval <= 0 {
+		interval = defaultHealthInterval
+	}
+
+	go h.monitor(ctx, interval)
+
+	return h
+}
+
+// Get returns the handler of the named endpoint, an empty name selects the default one
+func (h *endpointHandler) Get(name string) (Handler, error) {
+	e, err := h.engine(name)
+	if err != nil {
+		return nil, err
+	}
+
+	return e.get()
+}
+
+func (h *endpointHandler) GetAll() []pkgTypes.DockerEndpoint {
+	return h.endpoints
+}
+
+func (h *endpointHandler) Default() string {
+	return h.endpoints[0].Name
+}
+
+func (h *endpointHandler) Status(name string) (pkgTypes.EngineStatus, error) {
+	e, err := h.engine(name)
+	if err != nil {
+		return pkgTypes.EngineStatus{}, err
+	}
+
+	return e.getStatus(), nil
+}
+
+// Close stops the health checks and releases the connections of every engine
+func (h *endpointHandler) Close() error {
+	h.cancel()
+	<-h.done
+
+	var errs []error
+	for _, e := range h.engines {
+		errs = append(errs, e.close())
+	}
+
+	return errors.Join(errs...)
+}
+
+func (h *endpointHandler) engine(name string) (*engine, error) {
+	if name == "" {
+		name = h.Default()
+	}
+
+	e, ok := h.engines[name]
+	if !ok {
+		return nil, errors.New("unknown docker endpoint " + name)
+	}
+
+	return e, nil
+}
+
+func (h *endpointHandler) monitor(ctx context.Context, interval time.Duration) {
+	defer close(h.done)
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			for _, e := range h.engines {
+				e.check()
+			}
+		}
+	}
+}
+
+func newClient(endpoint pkgTypes.DockerEndpoint) (*client.Client, error) {
+	opts := []client.Opt{client.WithAPIVersionNegotiation()}
+
+	switch {
+	case endpoint.Host == "":
+		opts = append(opts, client.FromEnv)
+	case strings.HasPrefix(endpoint.Host, "ssh://"):
+		dialer, err := sshDialer(endpoint.Host)
+		if err != nil {
+			return nil, err
+		}
+
+		// the host is only used to build request urls, connections go through the dialer
+		opts = append(opts, client.WithHost("http://docker.example.com"), client.WithDialContext(dialer))
+	default:
+		opts = append(opts, client.WithHost(endpoint.Host))
+		if endpoint.TLSCACert != "" || endpoint.TLSCert != "" {
+			opts = append(opts, client.WithTLSClientConfig(endpoint.TLSCACert, endpoint.TLSCert, endpoint.TLSKey))
+		}
+	}
+
+	return client.NewClientWithOpts(opts...)
+}
@@ -0,0 +1,162 @@
+package docker
+
+import (
+	"context"
+	"errors"
+	pkgTypes "github.com/alpha-omega-corp/github-svc/pkg/types"
+	"github.com/alpha-omega-corp/services/types"
+	"github.com/docker/docker/client"
+	"log"
+	"sync"
+	"time"
+)
+
+const (
+	EngineIdle      = "idle"
+	EngineHealthy   = "healthy"
+	EngineUnhealthy = "unhealthy"
+	EngineClosed    = "closed"
+)
+
+const pingTimeout = 5 * time.Second
+
+// engine owns the client of one endpoint, it is only built when the endpoint is first used
+type engine struct {
+	endpoint pkgTypes.DockerEndpoint
+	config   types.Config
+
+	mu      sync.Mutex
+	cli     *client.Client
+	handler Handler
+	status  pkgTypes.EngineStatus
+}
+
+func newEngine(endpoint pkgTypes.DockerEndpoint, c types.Config) *engine {
+	return &engine{
+		endpoint: endpoint,
+		config:   c,
+		status: pkgTypes.EngineStatus{
+			Endpoint: endpoint.Name,
+			State:    EngineIdle,
+		},
+	}
+}
+
+func (e *engine) get() (Handler, error) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	if e.status.State == EngineClosed {
+		return nil, errors.New("docker endpoint " + e.endpoint.Name + " is closed")
+	}
+
+	if e.handler != nil {
+		return e.handler, nil
+	}
+
+	cli, err := newClient(e.endpoint)
+	if err != nil {
+		e.status.LastError = err.Error()
+		return nil, err
+	}
+
+	e.cli = cli
+	e.handler = NewHandler(cli, e.config)
+
+	go e.check()
+
+	return e.handler, nil
+}
+
+// check pings connected engines, idle ones are left alone until a request needs them
+func (e *engine) check() {
+	e.mu.Lock()
+	cli := e.cli
+	e.mu.Unlock()
+
+	if cli == nil {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), pingTimeout)
+	defer cancel()
+
+	res, err := cli.Ping(ctx)
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	if e.status.State == EngineClosed {
+		return
+	}
+
+	e.status.LastPing = time.Now()
+
+	if err != nil {
+		if e.status.State != EngineUnhealthy {
+			log.Printf("docker endpoint %s is unreachable: %v", e.endpoint.Name, err)
+
+			// connections kept alive across a daemon restart are dead, the next requests use a new client
+			if err := e.reconnect(cli); err != nil {
+				log.Printf("docker endpoint %s could not reconnect: %v", e.endpoint.Name, err)
+			}
+		}
+
+		e.status.State = EngineUnhealthy
+		e.status.Failures++
+		e.status.LastError = err.Error()
+		return
+	}
+
+	if e.status.State != EngineHealthy {
+		// a restarted daemon may have been downgraded in between
+		cli.NegotiateAPIVersionPing(res)
+		log.Printf("docker endpoint %s connected, api version %s (engine %s, %s)", e.endpoint.Name, cli.ClientVersion(), res.APIVersion, res.OSType)
+
+		e.status.ConnectedAt = time.Now()
+	}
+
+	e.status.State = EngineHealthy
+	e.status.Failures = 0
+	e.status.LastError = ""
+	e.status.ApiVersion = cli.ClientVersion()
+	e.status.OSType = res.OSType
+}
+
+// reconnect swaps in a new client and handler, requests still running on the old client finish before it is released.
+// It must be called with the lock held.
+func (e *engine) reconnect(old *client.Client) error {
+	if e.cli != old {
+		return nil
+	}
+
+	cli, err := newClient(e.endpoint)
+	if err != nil {
+		return err
+	}
+
+	e.cli = cli
+	e.handler = NewHandler(cli, e.config)
+
+	// only idle connections are closed, in flight requests keep theirs
+	return old.Close()
+}
+
+func (e *engine) getStatus() pkgTypes.EngineStatus {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	return e.status
+}
+
+func (e *engine) close() error {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	e.status.State = EngineClosed
+	if e.cli == nil {
+		return nil
+	}
+
+	return e.cli.Close()
+}
@@ -8,31 +8,65 @@ import (
 
 type Handler interface {
 	Container() handlers.ContainerHandler
+	Image() handlers.ImageHandler
+	Event() handlers.EventHandler
+	Network() handlers.NetworkHandler
+	Volume() handlers.VolumeHandler
+	Stack() handlers.StackHandler
+	Engine() handlers.EngineHandler
 }
 
 type dockerHandler struct {
 	Handler
-	ctHandler handlers.ContainerHandler
+	ctHandler  handlers.ContainerHandler
+	imgHandler handlers.ImageHandler
+	evtHandler handlers.EventHandler
+	netHandler handlers.NetworkHandler
+	volHandler handlers.VolumeHandler
+	stkHandler handlers.StackHandler
+	engHandler handlers.EngineHandler
 }
 
-func NewHandler(c types.Config) Handler {
-	cli, err := client.NewClientWithOpts(client.FromEnv, client.WithAPIVersionNegotiation())
-	if err != nil {
-		panic(err)
-	}
-
-	defer func(c *client.Client) {
-		err := c.Close()
-		if err != nil {
-			panic(err)
-		}
-	}(cli)
+func NewHandler(cli *client.Client, c types.Config) Handler {
+	img := handlers.NewImageHandler(cli, c)
+	ct := handlers.NewContainerHandler(cli, c, img)
+	net := handlers.NewNetworkHandler(cli)
 
 	return &dockerHandler{
-		ctHandler: handlers.NewContainerHandler(cli, c),
+		ctHandler:  ct,
+		imgHandler: img,
+		evtHandler: handlers.NewEventHandler(cli),
+		netHandler: net,
+		volHandler: handlers.NewVolumeHandler(cli),
+		stkHandler: handlers.NewStackHandler(ct, net),
+		engHandler: handlers.NewEngineHandler(cli),
 	}
 }
 
 func (h *dockerHandler) Container() handlers.ContainerHandler {
 	return h.ctHandler
 }
+
+func (h *dockerHandler) Image() handlers.ImageHandler {
+	return h.imgHandler
+}
+
+func (h *dockerHandler) Event() handlers.EventHandler {
+	return h.evtHandler
+}
+
+func (h *dockerHandler) Network() handlers.NetworkHandler {
+	return h.netHandler
+}
+
+func (h *dockerHandler) Volume() handlers.VolumeHandler {
+	return h.volHandler
+}
+
+func (h *dockerHandler) Stack() handlers.StackHandler {
+	return h.stkHandler
+}
+
+func (h *dockerHandler) Engine() handlers.EngineHandler {
+	return h.engHandler
+}
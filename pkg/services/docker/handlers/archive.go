@@ -0,0 +1,21 @@
+package handlers
+
+import (
+	"context"
+	docker "github.com/docker/docker/api/types"
+	"io"
+)
+
+// CopyTo extracts the tar archive read from content into the given directory of the container
+func (h *containerHandler) CopyTo(ctx context.Context, cId string, path string, content io.Reader, options docker.CopyToContainerOptions) error {
+	return h.client.CopyToContainer(ctx, cId, path, content, options)
+}
+
+// CopyFrom returns a tar archive of the given path inside the container, the caller must close it
+func (h *containerHandler) CopyFrom(ctx context.Context, cId string, path string) (io.ReadCloser, docker.ContainerPathStat, error) {
+	return h.client.CopyFromContainer(ctx, cId, path)
+}
+
+func (h *containerHandler) StatPath(ctx context.Context, cId string, path string) (docker.ContainerPathStat, error) {
+	return h.client.ContainerStatPath(ctx, cId, path)
+}
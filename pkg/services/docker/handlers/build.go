@@ -0,0 +1,107 @@
+package handlers
+
+import (
+	"archive/tar"
+	"bytes"
+	"context"
+	docker "github.com/docker/docker/api/types"
+	"github.com/docker/docker/api/types/registry"
+	"github.com/docker/docker/pkg/jsonmessage"
+	"io"
+	"path"
+	"sort"
+)
+
+// Build sends the build context to the engine, the registry credentials let it pull private base images
+func (h *imageHandler) Build(ctx context.Context, buildContext io.Reader, options docker.ImageBuildOptions, progress func(msg *jsonmessage.JSONMessage) error) error {
+	if options.AuthConfigs == nil {
+		options.AuthConfigs = map[string]registry.AuthConfig{}
+	}
+
+	options.AuthConfigs[h.config.Viper.GetString("registry")] = registry.AuthConfig{
+		Username: "packages",
+		Password: h.config.Viper.GetString("token"),
+	}
+
+	res, err := h.client.ImageBuild(ctx, buildContext, options)
+	if err != nil {
+		return err
+	}
+
+	return readProgress(res.Body, progress)
+}
+
+// ContextFile is a file of a build context, the mode is a git file mode
+type ContextFile struct {
+	Name    string
+	Mode    int64
+	Content []byte
+}
+
+const (
+	gitModeType    = 0170000
+	gitModeSymlink = 0120000
+)
+
+// BuildContext archives the files of a package directory into a tar build context, keeping their paths and modes
+func BuildContext(files []*ContextFile) (io.Reader, error) {
+	sorted := append([]*ContextFile(nil), files...)
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].Name < sorted[j].Name
+	})
+
+	buf := &bytes.Buffer{}
+	tw := tar.NewWriter(buf)
+
+	dirs := map[string]bool{}
+	for _, file := range sorted {
+		// parent directories are written before the files they contain
+		var parents []string
+		for dir := path.Dir(file.Name); dir != "." && dir != "/" && !dirs[dir]; dir = path.Dir(dir) {
+			parents = append(parents, dir)
+			dirs[dir] = true
+		}
+
+		for index := len(parents) - 1; index >= 0; index-- {
+			if err := tw.WriteHeader(&tar.Header{
+				Typeflag: tar.TypeDir,
+				Name:     parents[index] + "/",
+				Mode:     0755,
+			}); err != nil {
+				return nil, err
+			}
+		}
+
+		header := &tar.Header{
+			Typeflag: tar.TypeReg,
+			Name:     file.Name,
+			Mode:     file.Mode & 0777,
+			Size:     int64(len(file.Content)),
+		}
+
+		if file.Mode&gitModeType == gitModeSymlink {
+			header.Typeflag = tar.TypeSymlink
+			header.Linkname = string(file.Content)
+			header.Mode = 0777
+			header.Size = 0
+		}
+
+		if err := tw.WriteHeader(header); err != nil {
+			return nil, err
+		}
+
+		if header.Typeflag != tar.TypeReg {
+			continue
+		}
+
+		if _, err := tw.Write(file.Content); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := tw.Close(); err != nil {
+		return nil, err
+	}
+
+	return buf, nil
+}
@@ -0,0 +1,111 @@
+package handlers
+
+import (
+	"archive/tar"
+	"io"
+	"testing"
+)
+
+type tarEntry struct {
+	name     string
+	typeflag byte
+	mode     int64
+	linkname string
+	content  string
+}
+
+func TestBuildContext(t *testing.T) {
+	tests := []struct {
+		name  string
+		files []*ContextFile
+		want  []tarEntry
+	}{
+		{
+			name: "regular file",
+			files: []*ContextFile{
+				{Name: "Dockerfile", Mode: 0100644, Content: []byte("FROM scratch")},
+			},
+			want: []tarEntry{
+				{name: "Dockerfile", typeflag: tar.TypeReg, mode: 0644, content: "FROM scratch"},
+			},
+		},
+		{
+			name: "executable keeps its mode",
+			files: []*ContextFile{
+				{Name: "entrypoint.sh", Mode: 0100755, Content: []byte("#!/bin/sh")},
+			},
+			want: []tarEntry{
+				{name: "entrypoint.sh", typeflag: tar.TypeReg, mode: 0755, content: "#!/bin/sh"},
+			},
+		},
+		{
+			name: "symlink points at its content",
+			files: []*ContextFile{
+				{Name: "current", Mode: 0120000, Content: []byte("releases/v1")},
+			},
+			want: []tarEntry{
+				{name: "current", typeflag: tar.TypeSymlink, mode: 0777, linkname: "releases/v1"},
+			},
+		},
+		{
+			name: "nested paths get their parent directories once, files are sorted",
+			files: []*ContextFile{
+				{Name: "conf/nginx/site.conf", Mode: 0100644, Content: []byte("server {}")},
+				{Name: "Dockerfile", Mode: 0100644, Content: []byte("FROM nginx")},
+				{Name: "conf/nginx/nginx.conf", Mode: 0100644, Content: []byte("events {}")},
+			},
+			want: []tarEntry{
+				{name: "Dockerfile", typeflag: tar.TypeReg, mode: 0644, content: "FROM nginx"},
+				{name: "conf/", typeflag: tar.TypeDir, mode: 0755},
+				{name: "conf/nginx/", typeflag: tar.TypeDir, mode: 0755},
+				{name: "conf/nginx/nginx.conf", typeflag: tar.TypeReg, mode: 0644, content: "events {}"},
+				{name: "conf/nginx/site.conf", typeflag: tar.TypeReg, mode: 0644, content: "server {}"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			buildContext, err := BuildContext(tt.files)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			var entries []tarEntry
+			tr := tar.NewReader(buildContext)
+			for {
+				header, err := tr.Next()
+				if err == io.EOF {
+					break
+				}
+
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+
+				content, err := io.ReadAll(tr)
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+
+				entries = append(entries, tarEntry{
+					name:     header.Name,
+					typeflag: header.Typeflag,
+					mode:     header.Mode,
+					linkname: header.Linkname,
+					content:  string(content),
+				})
+			}
+
+			if len(entries) != len(tt.want) {
+				t.Fatalf("expected %d entries, got %d: %+v", len(tt.want), len(entries), entries)
+			}
+
+			for index, want := range tt.want {
+				if entries[index] != want {
+					t.Errorf("entry %d: expected %+v, got %+v", index, want, entries[index])
+				}
+			}
+		})
+	}
+}
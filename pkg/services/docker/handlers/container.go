@@ -2,38 +2,61 @@ package handlers
 
 import (
 	"context"
-	"encoding/base64"
-	"encoding/json"
+	"errors"
+	pkgTypes "github.com/alpha-omega-corp/github-svc/pkg/types"
 	"github.com/alpha-omega-corp/services/types"
 	docker "github.com/docker/docker/api/types"
 	"github.com/docker/docker/api/types/container"
 	"github.com/docker/docker/api/types/filters"
+	"github.com/docker/docker/api/types/network"
 	"github.com/docker/docker/client"
+	"github.com/docker/docker/pkg/stdcopy"
 	"io"
 	"strings"
+	"time"
 )
 
 type ContainerHandler interface {
-	CreateFrom(ctx context.Context, path string, name string) error
-	GetAll(ctx context.Context) ([]docker.Container, error)
+	CreateFrom(ctx context.Context, path string, name string, owner string, config *container.Config, hostConfig *container.HostConfig, networkConfig *network.NetworkingConfig) (string, error)
+	GetAll(ctx context.Context, all bool, filter filters.Args) ([]docker.Container, error)
 	GetAllFrom(ctx context.Context, path string) ([]docker.Container, error)
+	GetAllManaged(ctx context.Context, pkgName string, tag string, owner string) ([]docker.Container, error)
+	Inspect(ctx context.Context, cId string) (*docker.ContainerJSON, error)
+	Reconcile(ctx context.Context) ([]docker.Container, error)
 	Start(ctx context.Context, cId string) error
-	Stop(ctx context.Context, cId string) error
+	Stop(ctx context.Context, cId string, options container.StopOptions) error
+	Restart(ctx context.Context, cId string, options container.StopOptions) error
+	Kill(ctx context.Context, cId string, signal string) error
+	Pause(ctx context.Context, cId string) error
+	Unpause(ctx context.Context, cId string) error
+	Rename(ctx context.Context, cId string, name string) error
+	Update(ctx context.Context, cId string, config container.UpdateConfig) ([]string, error)
 	Delete(ctx context.Context, cId string) error
 	GetLogs(containerId string, ctx context.Context) (io.ReadCloser, error)
+	StreamLogs(ctx context.Context, cId string, options docker.ContainerLogsOptions, send func(line *pkgTypes.ContainerLogLine) error) error
+	Stats(ctx context.Context, cId string) (*pkgTypes.ContainerStats, error)
+	WatchStats(ctx context.Context, cId string, send func(stats *pkgTypes.ContainerStats) error) error
+	Exec(ctx context.Context, cId string, config docker.ExecConfig, stream *pkgTypes.ExecStream) (int, error)
+	WaitHealthy(ctx context.Context, cId string, timeout time.Duration) (*pkgTypes.ContainerHealth, error)
+	CopyTo(ctx context.Context, cId string, path string, content io.Reader, options docker.CopyToContainerOptions) error
+	CopyFrom(ctx context.Context, cId string, path string) (io.ReadCloser, docker.ContainerPathStat, error)
+	StatPath(ctx context.Context, cId string, path string) (docker.ContainerPathStat, error)
+	Commit(ctx context.Context, cId string, options docker.ContainerCommitOptions) (string, error)
 }
 
 type containerHandler struct {
 	ContainerHandler
 
-	client *client.Client
-	config types.Config
+	client     *client.Client
+	config     types.Config
+	imgHandler ImageHandler
 }
 
-func NewContainerHandler(cli *client.Client, c types.Config) ContainerHandler {
+func NewContainerHandler(cli *client.Client, c types.Config, img ImageHandler) ContainerHandler {
 	return &containerHandler{
-		client: cli,
-		config: c,
+		client:     cli,
+		config:     c,
+		imgHandler: img,
 	}
 }
 
@@ -41,8 +64,46 @@ func (h *containerHandler) Start(ctx context.Context, cId string) error {
 	return h.client.ContainerStart(ctx, cId, docker.ContainerStartOptions{})
 }
 
-func (h *containerHandler) Stop(ctx context.Context, cId string) error {
-	return h.client.ContainerStop(ctx, cId, container.StopOptions{})
+func (h *containerHandler) Stop(ctx context.Context, cId string, options container.StopOptions) error {
+	return h.client.ContainerStop(ctx, cId, options)
+}
+
+func (h *containerHandler) Restart(ctx context.Context, cId string, options container.StopOptions) error {
+	return h.client.ContainerRestart(ctx, cId, options)
+}
+
+func (h *containerHandler) Kill(ctx context.Context, cId string, signal string) error {
+	return h.client.ContainerKill(ctx, cId, signal)
+}
+
+func (h *containerHandler) Pause(ctx context.Context, cId string) error {
+	return h.client.ContainerPause(ctx, cId)
+}
+
+func (h *containerHandler) Unpause(ctx context.Context, cId string) error {
+	return h.client.ContainerUnpause(ctx, cId)
+}
+
+func (h *containerHandler) Rename(ctx context.Context, cId string, name string) error {
+	return h.client.ContainerRename(ctx, cId, name)
+}
+
+func (h *containerHandler) Update(ctx context.Context, cId string, config container.UpdateConfig) ([]string, error) {
+	res, err := h.client.ContainerUpdate(ctx, cId, config)
+	if err != nil {
+		return nil, err
+	}
+
+	return res.Warnings, nil
+}
+
+func (h *containerHandler) Commit(ctx context.Context, cId string, options docker.ContainerCommitOptions) (string, error) {
+	res, err := h.client.ContainerCommit(ctx, cId, options)
+	if err != nil {
+		return "", err
+	}
+
+	return res.ID, nil
 }
 
 func (h *containerHandler) Delete(ctx context.Context, cId string) error {
@@ -51,8 +112,11 @@ func (h *containerHandler) Delete(ctx context.Context, cId string) error {
 	})
 }
 
-func (h *containerHandler) GetAll(ctx context.Context) ([]docker.Container, error) {
-	containers, err := h.client.ContainerList(ctx, docker.ContainerListOptions{})
+func (h *containerHandler) GetAll(ctx context.Context, all bool, filter filters.Args) ([]docker.Container, error) {
+	containers, err := h.client.ContainerList(ctx, docker.ContainerListOptions{
+		All:     all,
+		Filters: filter,
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -60,6 +124,15 @@ func (h *containerHandler) GetAll(ctx context.Context) ([]docker.Container, erro
 	return containers, nil
 }
 
+func (h *containerHandler) Inspect(ctx context.Context, cId string) (*docker.ContainerJSON, error) {
+	info, err := h.client.ContainerInspect(ctx, cId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &info, nil
+}
+
 func (h *containerHandler) GetLogs(containerId string, ctx context.Context) (io.ReadCloser, error) {
 	options := docker.ContainerLogsOptions{
 		ShowStdout: true,
@@ -76,54 +149,182 @@ func (h *containerHandler) GetLogs(containerId string, ctx context.Context) (io.
 	return logs, nil
 }
 
-func (h *containerHandler) PullImage(imgName string, ctx context.Context) error {
-	authConfig := docker.AuthConfig{
-		Username: "packages",
-		Password: h.config.Viper.GetString("token"),
+func (h *containerHandler) StreamLogs(ctx context.Context, cId string, options docker.ContainerLogsOptions, send func(line *pkgTypes.ContainerLogLine) error) error {
+	info, err := h.client.ContainerInspect(ctx, cId)
+	if err != nil {
+		return err
 	}
 
-	encodedJSON, err := json.Marshal(authConfig)
+	logs, err := h.client.ContainerLogs(ctx, cId, options)
 	if err != nil {
 		return err
 	}
 
-	authString := base64.URLEncoding.EncodeToString(encodedJSON)
-	_, err = h.client.ImagePull(ctx, imgName, docker.ImagePullOptions{RegistryAuth: authString})
+	defer closeStream(logs)
+
+	stdout := newLineWriter(logLineEmitter("stdout", options.Timestamps, send))
+	stderr := newLineWriter(logLineEmitter("stderr", options.Timestamps, send))
+
+	// containers running with a tty do not multiplex their output
+	if info.Config.Tty {
+		_, err = io.Copy(stdout, logs)
+	} else {
+		_, err = stdcopy.StdCopy(stdout, stderr, logs)
+	}
+
 	if err != nil {
 		return err
 	}
 
-	return nil
+	if err := stdout.Flush(); err != nil {
+		return err
+	}
+
+	return stderr.Flush()
 }
 
+// GetAllFrom returns the containers of a package version, containers created before labels were stamped are matched on their image
 func (h *containerHandler) GetAllFrom(ctx context.Context, path string) ([]docker.Container, error) {
-	filter := filters.NewArgs(filters.KeyValuePair{Key: "ancestor", Value: h.imageName(path)})
+	pkgName, tag, _ := strings.Cut(path, "/")
+	managed, err := h.GetAllManaged(ctx, pkgName, tag, "")
+	if err != nil {
+		return nil, err
+	}
+
+	unlabeled, err := h.client.ContainerList(ctx, docker.ContainerListOptions{
+		All:     true,
+		Filters: filters.NewArgs(filters.KeyValuePair{Key: "ancestor", Value: h.imgHandler.Name(path)}),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	seen := make(map[string]bool, len(managed))
+	for _, c := range managed {
+		seen[c.ID] = true
+	}
+
+	for _, c := range unlabeled {
+		if !seen[c.ID] {
+			managed = append(managed, c)
+		}
+	}
+
+	return managed, nil
+}
+
+func (h *containerHandler) GetAllManaged(ctx context.Context, pkgName string, tag string, owner string) ([]docker.Container, error) {
+	filter := filters.NewArgs(filters.KeyValuePair{Key: "label", Value: LabelManaged + "=true"})
+	if pkgName != "" {
+		filter.Add("label", LabelPackage+"="+pkgName)
+	}
+
+	if tag != "" {
+		filter.Add("label", LabelTag+"="+tag)
+	}
+
+	if owner != "" {
+		filter.Add("label", LabelOwner+"="+owner)
+	}
+
 	return h.client.ContainerList(ctx, docker.ContainerListOptions{
 		All:     true,
 		Filters: filter,
 	})
 }
 
-func (h *containerHandler) CreateFrom(ctx context.Context, path string, name string) error {
-	imgName := h.imageName(path)
-	if err := h.PullImage(imgName, ctx); err != nil {
-		return err
+// Reconcile reports the managed containers whose package version no longer exists in the registry
+func (h *containerHandler) Reconcile(ctx context.Context) ([]docker.Container, error) {
+	containers, err := h.GetAllManaged(ctx, "", "", "")
+	if err != nil {
+		return nil, err
 	}
 
-	resp, err := h.client.ContainerCreate(ctx, &container.Config{
-		Image: imgName,
-	}, nil, nil, nil, name)
+	versions := make(map[string]bool)
+	var orphans []docker.Container
+	for _, c := range containers {
+		version := c.Labels[LabelVersion]
+		if version == "" {
+			continue
+		}
+
+		ref := h.imgHandler.Name(c.Labels[LabelPackage]) + "@" + version
+		exists, found := versions[ref]
+		if !found {
+			exists, err = h.imgHandler.Exists(ctx, ref)
+			if err != nil {
+				return nil, err
+			}
+
+			versions[ref] = exists
+		}
+
+		if !exists {
+			orphans = append(orphans, c)
+		}
+	}
+
+	return orphans, nil
+}
+
+func (h *containerHandler) CreateFrom(ctx context.Context, path string, name string, owner string, config *container.Config, hostConfig *container.HostConfig, networkConfig *network.NetworkingConfig) (string, error) {
+	imgName := h.imgHandler.Name(path)
+	if err := h.imgHandler.Pull(ctx, imgName, nil); err != nil {
+		return "", err
+	}
+
+	if config == nil {
+		config = &container.Config{}
+	}
+
+	digest, err := h.imgHandler.Digest(ctx, imgName)
 	if err != nil {
-		panic(err)
+		return "", err
 	}
 
+	config.Image = imgName
+	if config.Labels == nil {
+		config.Labels = make(map[string]string)
+	}
+
+	pkgName, tag, _ := strings.Cut(path, "/")
+	config.Labels[LabelManaged] = "true"
+	config.Labels[LabelPackage] = pkgName
+	config.Labels[LabelTag] = tag
+	config.Labels[LabelVersion] = digest
+	config.Labels[LabelOwner] = owner
+
+	resp, err := h.client.ContainerCreate(ctx, config, hostConfig, networkConfig, nil, name)
+	if err != nil {
+		return "", err
+	}
+
+	// a container that cannot start is removed so that its name can be reused
 	if err := h.client.ContainerStart(ctx, resp.ID, docker.ContainerStartOptions{}); err != nil {
-		panic(err)
+		if rmErr := h.client.ContainerRemove(context.Background(), resp.ID, docker.ContainerRemoveOptions{Force: true}); rmErr != nil {
+			return "", errors.Join(err, rmErr)
+		}
+
+		return "", err
 	}
 
-	return nil
+	return resp.ID, nil
 }
 
-func (h *containerHandler) imageName(path string) string {
-	return h.config.Viper.GetString("registry") + "/" + h.config.Viper.GetString("name") + "/" + strings.Replace(path, "/", ":", 1)
+func logLineEmitter(stream string, timestamps bool, send func(line *pkgTypes.ContainerLogLine) error) func(line string) error {
+	return func(line string) error {
+		logLine := &pkgTypes.ContainerLogLine{
+			Stream:  stream,
+			Content: line,
+		}
+
+		if timestamps {
+			if timestamp, content, found := strings.Cut(line, " "); found {
+				logLine.Timestamp = timestamp
+				logLine.Content = content
+			}
+		}
+
+		return send(logLine)
+	}
 }
@@ -0,0 +1,27 @@
+package handlers
+
+import (
+	"context"
+	docker "github.com/docker/docker/api/types"
+	"github.com/docker/docker/client"
+)
+
+type EngineHandler interface {
+	Version(ctx context.Context) (docker.Version, error)
+}
+
+type engineHandler struct {
+	EngineHandler
+
+	client *client.Client
+}
+
+func NewEngineHandler(cli *client.Client) EngineHandler {
+	return &engineHandler{
+		client: cli,
+	}
+}
+
+func (h *engineHandler) Version(ctx context.Context) (docker.Version, error) {
+	return h.client.ServerVersion(ctx)
+}
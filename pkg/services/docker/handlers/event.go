@@ -0,0 +1,58 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	docker "github.com/docker/docker/api/types"
+	"github.com/docker/docker/api/types/events"
+	"github.com/docker/docker/api/types/filters"
+	"github.com/docker/docker/client"
+	"io"
+)
+
+type EventHandler interface {
+	Watch(ctx context.Context, since string, until string, filter filters.Args, send func(msg events.Message) error) error
+}
+
+type eventHandler struct {
+	EventHandler
+
+	client *client.Client
+}
+
+func NewEventHandler(cli *client.Client) EventHandler {
+	return &eventHandler{
+		client: cli,
+	}
+}
+
+func (h *eventHandler) Watch(ctx context.Context, since string, until string, filter filters.Args, send func(msg events.Message) error) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	messages, errs := h.client.Events(ctx, docker.EventsOptions{
+		Since:   since,
+		Until:   until,
+		Filters: filter,
+	})
+
+	for {
+		select {
+		case msg, ok := <-messages:
+			if !ok {
+				return nil
+			}
+
+			if err := send(msg); err != nil {
+				return err
+			}
+		case err, ok := <-errs:
+			// the daemon ends the stream once until is reached
+			if !ok || errors.Is(err, io.EOF) {
+				return nil
+			}
+
+			return err
+		}
+	}
+}
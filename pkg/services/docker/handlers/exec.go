@@ -0,0 +1,82 @@
+package handlers
+
+import (
+	"context"
+	pkgTypes "github.com/alpha-omega-corp/github-svc/pkg/types"
+	docker "github.com/docker/docker/api/types"
+	"github.com/docker/docker/pkg/stdcopy"
+	"io"
+)
+
+// Exec runs a command inside the container, piping the given stream until the command exits and returns its exit code
+func (h *containerHandler) Exec(ctx context.Context, cId string, config docker.ExecConfig, stream *pkgTypes.ExecStream) (int, error) {
+	config.AttachStdin = stream.Stdin != nil
+	config.AttachStdout = true
+	config.AttachStderr = true
+	config.Detach = false
+
+	created, err := h.client.ContainerExecCreate(ctx, cId, config)
+	if err != nil {
+		return 0, err
+	}
+
+	attach, err := h.client.ContainerExecAttach(ctx, created.ID, docker.ExecStartCheck{
+		Tty:         config.Tty,
+		ConsoleSize: config.ConsoleSize,
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	defer attach.Close()
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	go func() {
+		for {
+			select {
+			case <-ctx.Done():
+				// unblocks the output copy when the caller goes away
+				_ = attach.Conn.Close()
+				return
+			case size, ok := <-stream.Resize:
+				if !ok {
+					return
+				}
+
+				// a failed resize only affects rendering, the session keeps going
+				_ = h.client.ContainerExecResize(ctx, created.ID, docker.ResizeOptions{
+					Height: size.Height,
+					Width:  size.Width,
+				})
+			}
+		}
+	}()
+
+	if stream.Stdin != nil {
+		go func() {
+			if _, err := io.Copy(attach.Conn, stream.Stdin); err == nil {
+				_ = attach.CloseWrite()
+			}
+		}()
+	}
+
+	// with a tty docker sends raw output instead of multiplexed frames
+	if config.Tty {
+		_, err = io.Copy(stream.Stdout, attach.Reader)
+	} else {
+		_, err = stdcopy.StdCopy(stream.Stdout, stream.Stderr, attach.Reader)
+	}
+
+	if err != nil {
+		return 0, err
+	}
+
+	inspect, err := h.client.ContainerExecInspect(ctx, created.ID)
+	if err != nil {
+		return 0, err
+	}
+
+	return inspect.ExitCode, nil
+}
@@ -0,0 +1,73 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	pkgTypes "github.com/alpha-omega-corp/github-svc/pkg/types"
+	docker "github.com/docker/docker/api/types"
+	"strings"
+	"time"
+)
+
+const healthPollInterval = 500 * time.Millisecond
+
+// WaitHealthy polls the container until its healthcheck settles, it exits or the timeout expires.
+// Containers without a healthcheck are considered ready once running.
+func (h *containerHandler) WaitHealthy(ctx context.Context, cId string, timeout time.Duration) (*pkgTypes.ContainerHealth, error) {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	ticker := time.NewTicker(healthPollInterval)
+	defer ticker.Stop()
+
+	var last *pkgTypes.ContainerHealth
+	for {
+		info, err := h.client.ContainerInspect(ctx, cId)
+		if err != nil {
+			if last != nil && errors.Is(err, context.DeadlineExceeded) {
+				return last, nil
+			}
+
+			return nil, err
+		}
+
+		last = ContainerHealth(info.State)
+		switch {
+		case info.State.Status != "created" && !info.State.Running && !info.State.Restarting:
+			return last, nil
+		case info.State.Running && info.State.Health == nil:
+			return last, nil
+		case last.Status == docker.Healthy || last.Status == docker.Unhealthy:
+			return last, nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return last, nil
+		case <-ticker.C:
+		}
+	}
+}
+
+func ContainerHealth(state *docker.ContainerState) *pkgTypes.ContainerHealth {
+	health := &pkgTypes.ContainerHealth{
+		State:    state.Status,
+		Status:   docker.NoHealthcheck,
+		ExitCode: state.ExitCode,
+	}
+
+	if state.Health == nil {
+		return health
+	}
+
+	health.Status = state.Health.Status
+	health.FailingStreak = state.Health.FailingStreak
+
+	if count := len(state.Health.Log); count != 0 {
+		probe := state.Health.Log[count-1]
+		health.Output = strings.TrimSpace(probe.Output)
+		health.ExitCode = probe.ExitCode
+	}
+
+	return health
+}
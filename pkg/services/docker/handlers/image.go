@@ -0,0 +1,226 @@
+package handlers
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"github.com/alpha-omega-corp/services/types"
+	"github.com/docker/distribution/reference"
+	docker "github.com/docker/docker/api/types"
+	"github.com/docker/docker/api/types/filters"
+	"github.com/docker/docker/client"
+	"github.com/docker/docker/errdefs"
+	"github.com/docker/docker/pkg/jsonmessage"
+	"io"
+	"strings"
+)
+
+type ImageHandler interface {
+	GetAll(ctx context.Context, all bool, filter filters.Args) ([]docker.ImageSummary, error)
+	Inspect(ctx context.Context, imgId string) (*docker.ImageInspect, error)
+	Pull(ctx context.Context, imgName string, progress func(msg *jsonmessage.JSONMessage) error) error
+	Build(ctx context.Context, buildContext io.Reader, options docker.ImageBuildOptions, progress func(msg *jsonmessage.JSONMessage) error) error
+	Push(ctx context.Context, imgName string, progress func(msg *jsonmessage.JSONMessage) error) (string, error)
+	Remove(ctx context.Context, imgId string, force bool, noPrune bool) ([]docker.ImageDeleteResponseItem, error)
+	Prune(ctx context.Context, all bool) (*docker.ImagesPruneReport, error)
+	Tag(ctx context.Context, source string, target string) error
+	Digest(ctx context.Context, imgName string) (string, error)
+	Exists(ctx context.Context, ref string) (bool, error)
+	Name(path string) string
+	Path(imgName string) (string, bool)
+	RegistryAuth() (string, error)
+}
+
+type imageHandler struct {
+	ImageHandler
+
+	client *client.Client
+	config types.Config
+}
+
+func NewImageHandler(cli *client.Client, c types.Config) ImageHandler {
+	return &imageHandler{
+		client: cli,
+		config: c,
+	}
+}
+
+func (h *imageHandler) GetAll(ctx context.Context, all bool, filter filters.Args) ([]docker.ImageSummary, error) {
+	return h.client.ImageList(ctx, docker.ImageListOptions{
+		All:     all,
+		Filters: filter,
+	})
+}
+
+func (h *imageHandler) Inspect(ctx context.Context, imgId string) (*docker.ImageInspect, error) {
+	img, _, err := h.client.ImageInspectWithRaw(ctx, imgId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &img, nil
+}
+
+func (h *imageHandler) Pull(ctx context.Context, imgName string, progress func(msg *jsonmessage.JSONMessage) error) error {
+	authString, err := h.authFor(imgName)
+	if err != nil {
+		return err
+	}
+
+	res, err := h.client.ImagePull(ctx, imgName, docker.ImagePullOptions{RegistryAuth: authString})
+	if err != nil {
+		return err
+	}
+
+	return readProgress(res, progress)
+}
+
+// Push uploads a tagged image to the registry and returns the digest of the pushed manifest
+func (h *imageHandler) Push(ctx context.Context, imgName string, progress func(msg *jsonmessage.JSONMessage) error) (string, error) {
+	authString, err := h.authFor(imgName)
+	if err != nil {
+		return "", err
+	}
+
+	res, err := h.client.ImagePush(ctx, imgName, docker.ImagePushOptions{RegistryAuth: authString})
+	if err != nil {
+		return "", err
+	}
+
+	var digest string
+	if err := readProgress(res, func(msg *jsonmessage.JSONMessage) error {
+		if msg.Aux != nil {
+			var result docker.PushResult
+			if err := json.Unmarshal(*msg.Aux, &result); err != nil {
+				return err
+			}
+
+			digest = result.Digest
+		}
+
+		if progress == nil {
+			return nil
+		}
+
+		return progress(msg)
+	}); err != nil {
+		return "", err
+	}
+
+	if digest == "" {
+		return "", errors.New("no digest reported for pushed image " + imgName)
+	}
+
+	return digest, nil
+}
+
+func (h *imageHandler) Remove(ctx context.Context, imgId string, force bool, noPrune bool) ([]docker.ImageDeleteResponseItem, error) {
+	return h.client.ImageRemove(ctx, imgId, docker.ImageRemoveOptions{
+		Force:         force,
+		PruneChildren: !noPrune,
+	})
+}
+
+func (h *imageHandler) Prune(ctx context.Context, all bool) (*docker.ImagesPruneReport, error) {
+	filter := filters.NewArgs()
+	if all {
+		filter.Add("dangling", "false")
+	}
+
+	report, err := h.client.ImagesPrune(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	return &report, nil
+}
+
+func (h *imageHandler) Tag(ctx context.Context, source string, target string) error {
+	return h.client.ImageTag(ctx, source, target)
+}
+
+// Digest returns the registry digest of a pulled image, it matches the name of its package version
+func (h *imageHandler) Digest(ctx context.Context, imgName string) (string, error) {
+	img, err := h.Inspect(ctx, imgName)
+	if err != nil {
+		return "", err
+	}
+
+	repository := imgName
+	if index := strings.LastIndex(imgName, ":"); index > strings.LastIndex(imgName, "/") {
+		repository = imgName[:index]
+	}
+
+	for _, repoDigest := range img.RepoDigests {
+		if name, digest, found := strings.Cut(repoDigest, "@"); found && name == repository {
+			return digest, nil
+		}
+	}
+
+	return "", errors.New("no registry digest found for image " + imgName)
+}
+
+func (h *imageHandler) Exists(ctx context.Context, ref string) (bool, error) {
+	authString, err := h.authFor(ref)
+	if err != nil {
+		return false, err
+	}
+
+	if _, err := h.client.DistributionInspect(ctx, ref, authString); err != nil {
+		if errdefs.IsNotFound(err) {
+			return false, nil
+		}
+
+		return false, err
+	}
+
+	return true, nil
+}
+
+func (h *imageHandler) Name(path string) string {
+	return h.config.Viper.GetString("registry") + "/" + h.config.Viper.GetString("name") + "/" + strings.Replace(path, "/", ":", 1)
+}
+
+func (h *imageHandler) Path(imgName string) (string, bool) {
+	prefix := h.config.Viper.GetString("registry") + "/" + h.config.Viper.GetString("name") + "/"
+	if !strings.HasPrefix(imgName, prefix) {
+		return "", false
+	}
+
+	name, tag, found := strings.Cut(strings.TrimPrefix(imgName, prefix), ":")
+	if !found {
+		tag = "latest"
+	}
+
+	return name + "/" + tag, true
+}
+
+func (h *imageHandler) RegistryAuth() (string, error) {
+	authConfig := docker.AuthConfig{
+		Username: "packages",
+		Password: h.config.Viper.GetString("token"),
+	}
+
+	encodedJSON, err := json.Marshal(authConfig)
+	if err != nil {
+		return "", err
+	}
+
+	return base64.URLEncoding.EncodeToString(encodedJSON), nil
+}
+
+// authFor only sends the org token to the configured registry, other registries are accessed anonymously
+func (h *imageHandler) authFor(ref string) (string, error) {
+	named, err := reference.ParseNormalizedNamed(ref)
+	if err != nil {
+		return "", err
+	}
+
+	registry, _, _ := strings.Cut(h.config.Viper.GetString("registry"), "/")
+	if !strings.EqualFold(reference.Domain(named), registry) {
+		return "", nil
+	}
+
+	return h.RegistryAuth()
+}
@@ -0,0 +1,25 @@
+package handlers
+
+// labels stamped on every container created by this service, they tie a container back to its package version
+const (
+	LabelManaged = "com.alpha-omega-corp.github-svc.managed"
+	LabelPackage = "com.alpha-omega-corp.github-svc.package"
+	LabelTag     = "com.alpha-omega-corp.github-svc.tag"
+	LabelVersion = "com.alpha-omega-corp.github-svc.version"
+	LabelOwner   = "com.alpha-omega-corp.github-svc.owner"
+	LabelStack   = "com.alpha-omega-corp.github-svc.stack"
+	LabelService = "com.alpha-omega-corp.github-svc.service"
+)
+
+// packageLabels copies the caller labels before stamping the package on them, request maps must not be modified
+func packageLabels(labels map[string]string, pkgName string) map[string]string {
+	res := make(map[string]string, len(labels)+2)
+	for key, value := range labels {
+		res[key] = value
+	}
+
+	res[LabelManaged] = "true"
+	res[LabelPackage] = pkgName
+
+	return res
+}
@@ -0,0 +1,78 @@
+package handlers
+
+import (
+	"context"
+	docker "github.com/docker/docker/api/types"
+	"github.com/docker/docker/api/types/filters"
+	"github.com/docker/docker/api/types/network"
+	"github.com/docker/docker/client"
+)
+
+type NetworkHandler interface {
+	Create(ctx context.Context, name string, pkgName string, options docker.NetworkCreate) (string, error)
+	GetAll(ctx context.Context, filter filters.Args) ([]docker.NetworkResource, error)
+	Inspect(ctx context.Context, nId string) (*docker.NetworkResource, error)
+	Delete(ctx context.Context, nId string) error
+	Connect(ctx context.Context, nId string, cId string, aliases []string) error
+	Disconnect(ctx context.Context, nId string, cId string, force bool) error
+}
+
+type networkHandler struct {
+	NetworkHandler
+
+	client *client.Client
+}
+
+func NewNetworkHandler(cli *client.Client) NetworkHandler {
+	return &networkHandler{
+		client: cli,
+	}
+}
+
+// Create creates a user-defined network, networks created for a package are named after it unless a name is given
+func (h *networkHandler) Create(ctx context.Context, name string, pkgName string, options docker.NetworkCreate) (string, error) {
+	if pkgName != "" {
+		if name == "" {
+			name = pkgName
+		}
+
+		options.Labels = packageLabels(options.Labels, pkgName)
+	}
+
+	options.CheckDuplicate = true
+	res, err := h.client.NetworkCreate(ctx, name, options)
+	if err != nil {
+		return "", err
+	}
+
+	return res.ID, nil
+}
+
+func (h *networkHandler) GetAll(ctx context.Context, filter filters.Args) ([]docker.NetworkResource, error) {
+	return h.client.NetworkList(ctx, docker.NetworkListOptions{
+		Filters: filter,
+	})
+}
+
+func (h *networkHandler) Inspect(ctx context.Context, nId string) (*docker.NetworkResource, error) {
+	res, err := h.client.NetworkInspect(ctx, nId, docker.NetworkInspectOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+func (h *networkHandler) Delete(ctx context.Context, nId string) error {
+	return h.client.NetworkRemove(ctx, nId)
+}
+
+func (h *networkHandler) Connect(ctx context.Context, nId string, cId string, aliases []string) error {
+	return h.client.NetworkConnect(ctx, nId, cId, &network.EndpointSettings{
+		Aliases: aliases,
+	})
+}
+
+func (h *networkHandler) Disconnect(ctx context.Context, nId string, cId string, force bool) error {
+	return h.client.NetworkDisconnect(ctx, nId, cId, force)
+}
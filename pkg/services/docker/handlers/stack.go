@@ -0,0 +1,337 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	docker "github.com/docker/docker/api/types"
+	"github.com/docker/docker/api/types/container"
+	"github.com/docker/docker/api/types/filters"
+	"github.com/docker/docker/api/types/network"
+	"log"
+	"sort"
+	"strings"
+)
+
+type StackService struct {
+	Name       string
+	Path       string
+	DependsOn  []string
+	Config     *container.Config
+	HostConfig *container.HostConfig
+}
+
+type StackHandler interface {
+	Deploy(ctx context.Context, stack string, owner string, networkName string, services []*StackService) error
+	Get(ctx context.Context, stack string) ([]docker.Container, error)
+	Remove(ctx context.Context, stack string) error
+}
+
+type stackHandler struct {
+	StackHandler
+
+	ctHandler  ContainerHandler
+	netHandler NetworkHandler
+}
+
+func NewStackHandler(ct ContainerHandler, net NetworkHandler) StackHandler {
+	return &stackHandler{
+		ctHandler:  ct,
+		netHandler: net,
+	}
+}
+
+// previous containers are renamed with this suffix while a deploy replaces them
+const previousSuffix = "-previous"
+
+type previousContainer struct {
+	id      string
+	name    string
+	running bool
+}
+
+// Deploy replaces the containers of a stack, services are created in dependency order on a shared network.
+// The previous containers are stopped and set aside until the new ones are created, a failed deploy restores them.
+func (h *stackHandler) Deploy(ctx context.Context, stack string, owner string, networkName string, services []*StackService) error {
+	ordered, err := dependencyOrder(services)
+	if err != nil {
+		return err
+	}
+
+	if networkName == "" {
+		networkName = stack
+	}
+
+	if err := h.ensureNetwork(ctx, stack, networkName); err != nil {
+		return err
+	}
+
+	previous, err := h.setAside(ctx, stack)
+	if err != nil {
+		return errors.Join(err, h.restore(nil, previous))
+	}
+
+	created, err := h.createServices(ctx, stack, owner, networkName, ordered)
+	if err != nil {
+		return errors.Join(err, h.restore(created, previous))
+	}
+
+	// the new stack is running, leftovers are cleaned up by the next deploy
+	for _, c := range previous {
+		if err := h.ctHandler.Delete(ctx, c.id); err != nil {
+			log.Printf("failed to remove previous container %s of stack %s: %v", c.name, stack, err)
+		}
+	}
+
+	return nil
+}
+
+func (h *stackHandler) createServices(ctx context.Context, stack string, owner string, networkName string, ordered []*StackService) ([]string, error) {
+	var created []string
+	for _, service := range ordered {
+		config := service.Config
+		if config == nil {
+			config = &container.Config{}
+		}
+
+		hostConfig := service.HostConfig
+		if hostConfig == nil {
+			hostConfig = &container.HostConfig{}
+		}
+
+		if config.Labels == nil {
+			config.Labels = make(map[string]string)
+		}
+
+		config.Labels[LabelStack] = stack
+		config.Labels[LabelService] = service.Name
+
+		// services reach each other by name on the stack network
+		hostConfig.NetworkMode = container.NetworkMode(networkName)
+		networkConfig := &network.NetworkingConfig{
+			EndpointsConfig: map[string]*network.EndpointSettings{
+				networkName: {
+					Aliases: []string{service.Name},
+				},
+			},
+		}
+
+		cId, err := h.ctHandler.CreateFrom(ctx, service.Path, stack+"-"+service.Name, owner, config, hostConfig, networkConfig)
+		if err != nil {
+			return created, err
+		}
+
+		created = append(created, cId)
+	}
+
+	return created, nil
+}
+
+// setAside stops the current containers of a stack newest first and renames them so that their names can be reused.
+// The containers already set aside are returned with the error.
+func (h *stackHandler) setAside(ctx context.Context, stack string) ([]*previousContainer, error) {
+	containers, err := h.Get(ctx, stack)
+	if err != nil {
+		return nil, err
+	}
+
+	sort.SliceStable(containers, func(i, j int) bool {
+		return containers[i].Created > containers[j].Created
+	})
+
+	var previous []*previousContainer
+	for _, c := range containers {
+		name := strings.TrimPrefix(c.Names[0], "/")
+
+		// leftovers of an interrupted deploy
+		if strings.HasSuffix(name, previousSuffix) {
+			if err := h.ctHandler.Delete(ctx, c.ID); err != nil {
+				return previous, err
+			}
+
+			continue
+		}
+
+		if err := h.ctHandler.Stop(ctx, c.ID, container.StopOptions{}); err != nil {
+			return previous, err
+		}
+
+		if err := h.ctHandler.Rename(ctx, c.ID, name+previousSuffix); err != nil {
+			if c.State == "running" {
+				err = errors.Join(err, h.ctHandler.Start(context.Background(), c.ID))
+			}
+
+			return previous, err
+		}
+
+		previous = append(previous, &previousContainer{
+			id:      c.ID,
+			name:    name,
+			running: c.State == "running",
+		})
+	}
+
+	return previous, nil
+}
+
+// restore deletes the containers of a failed deploy and brings the previous ones back in dependency order
+func (h *stackHandler) restore(created []string, previous []*previousContainer) error {
+	// the request context may be the reason the deploy failed
+	ctx := context.Background()
+
+	var errs []error
+	for index := len(created) - 1; index >= 0; index-- {
+		if err := h.ctHandler.Delete(ctx, created[index]); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	for index := len(previous) - 1; index >= 0; index-- {
+		prev := previous[index]
+		if err := h.ctHandler.Rename(ctx, prev.id, prev.name); err != nil {
+			errs = append(errs, err)
+			continue
+		}
+
+		if prev.running {
+			if err := h.ctHandler.Start(ctx, prev.id); err != nil {
+				errs = append(errs, err)
+			}
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+func (h *stackHandler) Get(ctx context.Context, stack string) ([]docker.Container, error) {
+	return h.ctHandler.GetAll(ctx, true, filters.NewArgs(filters.KeyValuePair{Key: "label", Value: LabelStack + "=" + stack}))
+}
+
+func (h *stackHandler) Remove(ctx context.Context, stack string) error {
+	if err := h.removeContainers(ctx, stack); err != nil {
+		return err
+	}
+
+	networks, err := h.netHandler.GetAll(ctx, filters.NewArgs(filters.KeyValuePair{Key: "label", Value: LabelStack + "=" + stack}))
+	if err != nil {
+		return err
+	}
+
+	for _, n := range networks {
+		if err := h.netHandler.Delete(ctx, n.ID); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// removeContainers deletes the stack containers newest first, which reverses the dependency order they were created in
+func (h *stackHandler) removeContainers(ctx context.Context, stack string) error {
+	containers, err := h.Get(ctx, stack)
+	if err != nil {
+		return err
+	}
+
+	sort.SliceStable(containers, func(i, j int) bool {
+		return containers[i].Created > containers[j].Created
+	})
+
+	for _, c := range containers {
+		if err := h.ctHandler.Delete(ctx, c.ID); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (h *stackHandler) ensureNetwork(ctx context.Context, stack string, networkName string) error {
+	networks, err := h.netHandler.GetAll(ctx, filters.NewArgs(filters.KeyValuePair{Key: "name", Value: networkName}))
+	if err != nil {
+		return err
+	}
+
+	// the name filter also matches on substrings
+	for _, n := range networks {
+		if n.Name == networkName {
+			return nil
+		}
+	}
+
+	_, err = h.netHandler.Create(ctx, networkName, "", docker.NetworkCreate{
+		Labels: map[string]string{
+			LabelManaged: "true",
+			LabelStack:   stack,
+		},
+	})
+
+	return err
+}
+
+// ValidateStack checks the service names and that their dependencies can be ordered
+func ValidateStack(services []*StackService) error {
+	_, err := dependencyOrder(services)
+	return err
+}
+
+func dependencyOrder(services []*StackService) ([]*StackService, error) {
+	byName := make(map[string]*StackService, len(services))
+	for _, service := range services {
+		if service.Name == "" {
+			return nil, errors.New("stack services must have a name")
+		}
+
+		if _, found := byName[service.Name]; found {
+			return nil, errors.New("duplicate stack service " + service.Name)
+		}
+
+		byName[service.Name] = service
+	}
+
+	for _, service := range services {
+		for _, dependency := range service.DependsOn {
+			if _, found := byName[dependency]; !found {
+				return nil, errors.New("stack service " + service.Name + " depends on unknown service " + dependency)
+			}
+		}
+	}
+
+	const (
+		unvisited = iota
+		visiting
+		visited
+	)
+
+	state := make(map[string]int, len(services))
+	ordered := make([]*StackService, 0, len(services))
+
+	var visit func(service *StackService) error
+	visit = func(service *StackService) error {
+		switch state[service.Name] {
+		case visited:
+			return nil
+		case visiting:
+			return errors.New("stack has a dependency cycle through service " + service.Name)
+		}
+
+		state[service.Name] = visiting
+		for _, dependency := range service.DependsOn {
+			if err := visit(byName[dependency]); err != nil {
+				return err
+			}
+		}
+
+		state[service.Name] = visited
+		ordered = append(ordered, service)
+
+		return nil
+	}
+
+	for _, service := range services {
+		if err := visit(service); err != nil {
+			return nil, err
+		}
+	}
+
+	return ordered, nil
+}
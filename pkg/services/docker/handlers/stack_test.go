@@ -0,0 +1,111 @@
+package handlers
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestDependencyOrder(t *testing.T) {
+	tests := []struct {
+		name     string
+		services []*StackService
+		want     []string
+		err      string
+	}{
+		{
+			name: "no dependencies keeps the declared order",
+			services: []*StackService{
+				{Name: "web"},
+				{Name: "db"},
+			},
+			want: []string{"web", "db"},
+		},
+		{
+			name: "dependencies come first",
+			services: []*StackService{
+				{Name: "web", DependsOn: []string{"api"}},
+				{Name: "api", DependsOn: []string{"db", "cache"}},
+				{Name: "cache"},
+				{Name: "db"},
+			},
+			want: []string{"db", "cache", "api", "web"},
+		},
+		{
+			name: "shared dependencies are created once",
+			services: []*StackService{
+				{Name: "web", DependsOn: []string{"db"}},
+				{Name: "worker", DependsOn: []string{"db"}},
+				{Name: "db"},
+			},
+			want: []string{"db", "web", "worker"},
+		},
+		{
+			name: "cycle",
+			services: []*StackService{
+				{Name: "a", DependsOn: []string{"b"}},
+				{Name: "b", DependsOn: []string{"c"}},
+				{Name: "c", DependsOn: []string{"a"}},
+			},
+			err: "dependency cycle",
+		},
+		{
+			name: "self dependency",
+			services: []*StackService{
+				{Name: "a", DependsOn: []string{"a"}},
+			},
+			err: "dependency cycle",
+		},
+		{
+			name: "unknown dependency",
+			services: []*StackService{
+				{Name: "web", DependsOn: []string{"db"}},
+			},
+			err: "depends on unknown service db",
+		},
+		{
+			name: "duplicate service",
+			services: []*StackService{
+				{Name: "web"},
+				{Name: "web"},
+			},
+			err: "duplicate stack service web",
+		},
+		{
+			name: "missing name",
+			services: []*StackService{
+				{Name: ""},
+			},
+			err: "must have a name",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ordered, err := dependencyOrder(tt.services)
+			if tt.err != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.err) {
+					t.Fatalf("expected an error containing %q, got %v", tt.err, err)
+				}
+
+				if vErr := ValidateStack(tt.services); vErr == nil {
+					t.Fatalf("expected ValidateStack to reject the stack")
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			names := make([]string, len(ordered))
+			for index, service := range ordered {
+				names[index] = service.Name
+			}
+
+			if strings.Join(names, ",") != strings.Join(tt.want, ",") {
+				t.Fatalf("expected order %v, got %v", tt.want, names)
+			}
+		})
+	}
+}
@@ -0,0 +1,109 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	pkgTypes "github.com/alpha-omega-corp/github-svc/pkg/types"
+	docker "github.com/docker/docker/api/types"
+	"io"
+	"strings"
+)
+
+func (h *containerHandler) Stats(ctx context.Context, cId string) (*pkgTypes.ContainerStats, error) {
+	var stats *pkgTypes.ContainerStats
+	err := h.readStats(ctx, cId, false, func(s *pkgTypes.ContainerStats) error {
+		stats = s
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	if stats == nil {
+		return nil, errors.New("no stats received for container " + cId)
+	}
+
+	return stats, nil
+}
+
+func (h *containerHandler) WatchStats(ctx context.Context, cId string, send func(stats *pkgTypes.ContainerStats) error) error {
+	return h.readStats(ctx, cId, true, send)
+}
+
+func (h *containerHandler) readStats(ctx context.Context, cId string, stream bool, send func(stats *pkgTypes.ContainerStats) error) error {
+	res, err := h.client.ContainerStats(ctx, cId, stream)
+	if err != nil {
+		return err
+	}
+
+	defer closeStream(res.Body)
+
+	decoder := json.NewDecoder(res.Body)
+	for {
+		raw := new(docker.StatsJSON)
+		if err := decoder.Decode(raw); err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil
+			}
+
+			return err
+		}
+
+		if err := send(containerStats(raw)); err != nil {
+			return err
+		}
+	}
+}
+
+// containerStats computes the same figures as the docker cli `docker stats` command
+func containerStats(raw *docker.StatsJSON) *pkgTypes.ContainerStats {
+	stats := &pkgTypes.ContainerStats{
+		Id:          raw.ID,
+		Name:        strings.TrimPrefix(raw.Name, "/"),
+		Read:        raw.Read,
+		MemoryLimit: raw.MemoryStats.Limit,
+		Pids:        raw.PidsStats.Current,
+	}
+
+	cpuDelta := float64(raw.CPUStats.CPUUsage.TotalUsage) - float64(raw.PreCPUStats.CPUUsage.TotalUsage)
+	systemDelta := float64(raw.CPUStats.SystemUsage) - float64(raw.PreCPUStats.SystemUsage)
+
+	onlineCPUs := float64(raw.CPUStats.OnlineCPUs)
+	if onlineCPUs == 0 {
+		onlineCPUs = float64(len(raw.CPUStats.CPUUsage.PercpuUsage))
+	}
+
+	if cpuDelta > 0 && systemDelta > 0 {
+		stats.CpuPercent = cpuDelta / systemDelta * onlineCPUs * 100
+	}
+
+	// page cache is reported as usage but can be reclaimed, cgroup v1 and v2 name it differently
+	stats.MemoryUsage = raw.MemoryStats.Usage
+	if cache, ok := raw.MemoryStats.Stats["total_inactive_file"]; ok && cache < stats.MemoryUsage {
+		stats.MemoryUsage -= cache
+	} else if cache, ok := raw.MemoryStats.Stats["inactive_file"]; ok && cache < stats.MemoryUsage {
+		stats.MemoryUsage -= cache
+	}
+
+	if stats.MemoryLimit != 0 {
+		stats.MemoryPercent = float64(stats.MemoryUsage) / float64(stats.MemoryLimit) * 100
+	}
+
+	for _, network := range raw.Networks {
+		stats.NetworkRx += network.RxBytes
+		stats.NetworkTx += network.TxBytes
+	}
+
+	for _, entry := range raw.BlkioStats.IoServiceBytesRecursive {
+		switch strings.ToLower(entry.Op) {
+		case "read":
+			stats.BlockRead += entry.Value
+		case "write":
+			stats.BlockWrite += entry.Value
+		}
+	}
+
+	return stats
+}
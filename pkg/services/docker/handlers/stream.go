@@ -0,0 +1,84 @@
+package handlers
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"github.com/docker/docker/pkg/jsonmessage"
+	"io"
+	"log"
+)
+
+type lineWriter struct {
+	buf  bytes.Buffer
+	emit func(line string) error
+}
+
+func newLineWriter(emit func(line string) error) *lineWriter {
+	return &lineWriter{
+		emit: emit,
+	}
+}
+
+func (w *lineWriter) Write(p []byte) (int, error) {
+	w.buf.Write(p)
+
+	for {
+		index := bytes.IndexByte(w.buf.Bytes(), '\n')
+		if index < 0 {
+			break
+		}
+
+		line := string(w.buf.Next(index + 1))
+		if err := w.emit(line[:index]); err != nil {
+			return 0, err
+		}
+	}
+
+	return len(p), nil
+}
+
+func (w *lineWriter) Flush() error {
+	if w.buf.Len() == 0 {
+		return nil
+	}
+
+	line := w.buf.String()
+	w.buf.Reset()
+
+	return w.emit(line)
+}
+
+// closeStream releases a docker response, a failure after the caller went away is only worth a log line
+func closeStream(body io.Closer) {
+	if err := body.Close(); err != nil {
+		log.Printf("failed to close docker stream: %v", err)
+	}
+}
+
+// readProgress drains a docker json message stream, it must be fully read for pulls and pushes to complete
+func readProgress(body io.ReadCloser, send func(msg *jsonmessage.JSONMessage) error) error {
+	defer closeStream(body)
+
+	decoder := json.NewDecoder(body)
+	for {
+		msg := new(jsonmessage.JSONMessage)
+		if err := decoder.Decode(msg); err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil
+			}
+
+			return err
+		}
+
+		if msg.Error != nil {
+			return msg.Error
+		}
+
+		if send != nil {
+			if err := send(msg); err != nil {
+				return err
+			}
+		}
+	}
+}
@@ -0,0 +1,64 @@
+package handlers
+
+import (
+	"context"
+	"github.com/docker/docker/api/types/filters"
+	"github.com/docker/docker/api/types/volume"
+	"github.com/docker/docker/client"
+)
+
+type VolumeHandler interface {
+	Create(ctx context.Context, pkgName string, options volume.CreateOptions) (*volume.Volume, error)
+	GetAll(ctx context.Context, filter filters.Args) ([]*volume.Volume, error)
+	Inspect(ctx context.Context, vId string) (*volume.Volume, error)
+	Delete(ctx context.Context, vId string, force bool) error
+}
+
+type volumeHandler struct {
+	VolumeHandler
+
+	client *client.Client
+}
+
+func NewVolumeHandler(cli *client.Client) VolumeHandler {
+	return &volumeHandler{
+		client: cli,
+	}
+}
+
+func (h *volumeHandler) Create(ctx context.Context, pkgName string, options volume.CreateOptions) (*volume.Volume, error) {
+	if pkgName != "" {
+		options.Labels = packageLabels(options.Labels, pkgName)
+	}
+
+	res, err := h.client.VolumeCreate(ctx, options)
+	if err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+func (h *volumeHandler) GetAll(ctx context.Context, filter filters.Args) ([]*volume.Volume, error) {
+	res, err := h.client.VolumeList(ctx, volume.ListOptions{
+		Filters: filter,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return res.Volumes, nil
+}
+
+func (h *volumeHandler) Inspect(ctx context.Context, vId string) (*volume.Volume, error) {
+	res, err := h.client.VolumeInspect(ctx, vId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+func (h *volumeHandler) Delete(ctx context.Context, vId string, force bool) error {
+	return h.client.VolumeRemove(ctx, vId, force)
+}
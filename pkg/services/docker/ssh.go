@@ -0,0 +1,117 @@
+package docker
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net"
+	"net/url"
+	"os/exec"
+	"time"
+)
+
+// sshDialer tunnels the engine API through `docker system dial-stdio` on the remote host
+func sshDialer(host string) (func(ctx context.Context, network string, addr string) (net.Conn, error), error) {
+	u, err := url.Parse(host)
+	if err != nil {
+		return nil, err
+	}
+
+	if u.Hostname() == "" {
+		return nil, errors.New("no host in ssh endpoint " + host)
+	}
+
+	// prompts for a password or an unknown host key would block the dial forever
+	args := []string{"-o", "BatchMode=yes"}
+	if u.User != nil {
+		args = append(args, "-l", u.User.Username())
+	}
+
+	if u.Port() != "" {
+		args = append(args, "-p", u.Port())
+	}
+
+	args = append(args, "--", u.Hostname(), "docker", "system", "dial-stdio")
+
+	return func(ctx context.Context, network string, addr string) (net.Conn, error) {
+		// the process outlives the dial context, it is stopped when the connection closes
+		cmd := exec.Command("ssh", args...)
+
+		stdin, err := cmd.StdinPipe()
+		if err != nil {
+			return nil, err
+		}
+
+		stdout, err := cmd.StdoutPipe()
+		if err != nil {
+			return nil, err
+		}
+
+		if err := cmd.Start(); err != nil {
+			return nil, err
+		}
+
+		return &commandConn{
+			cmd:    cmd,
+			stdin:  stdin,
+			stdout: stdout,
+		}, nil
+	}, nil
+}
+
+type commandConn struct {
+	cmd    *exec.Cmd
+	stdin  io.WriteCloser
+	stdout io.ReadCloser
+}
+
+func (c *commandConn) Read(p []byte) (int, error) {
+	return c.stdout.Read(p)
+}
+
+func (c *commandConn) Write(p []byte) (int, error) {
+	return c.stdin.Write(p)
+}
+
+// CloseWrite lets hijacked connections signal the end of stdin
+func (c *commandConn) CloseWrite() error {
+	return c.stdin.Close()
+}
+
+func (c *commandConn) Close() error {
+	_ = c.stdin.Close()
+	_ = c.cmd.Process.Kill()
+	_ = c.cmd.Wait()
+
+	return nil
+}
+
+func (c *commandConn) LocalAddr() net.Addr {
+	return commandAddr{}
+}
+
+func (c *commandConn) RemoteAddr() net.Addr {
+	return commandAddr{}
+}
+
+func (c *commandConn) SetDeadline(time.Time) error {
+	return nil
+}
+
+func (c *commandConn) SetReadDeadline(time.Time) error {
+	return nil
+}
+
+func (c *commandConn) SetWriteDeadline(time.Time) error {
+	return nil
+}
+
+type commandAddr struct{}
+
+func (commandAddr) Network() string {
+	return "ssh"
+}
+
+func (commandAddr) String() string {
+	return "ssh"
+}
@@ -10,8 +10,10 @@ type Handler interface {
 	Repositories() handlers.RepositoryHandler
 	Packages() handlers.PackageHandler
 	Secrets() handlers.SecretsHandler
+	Variables() handlers.VariablesHandler
 	Templates() handlers.TemplateHandler
 	Exec() handlers.ExecHandler
+	History() handlers.HistoryHandler
 }
 
 type gitHandler struct {
@@ -20,26 +22,30 @@ type gitHandler struct {
 	repoHandler    handlers.RepositoryHandler
 	pkgHandler     handlers.PackageHandler
 	secretsHandler handlers.SecretsHandler
+	varsHandler    handlers.VariablesHandler
 	tmplHandler    handlers.TemplateHandler
 	execHandler    handlers.ExecHandler
+	historyHandler handlers.HistoryHandler
 }
 
 func NewHandler(c types.Config) Handler {
 	client := github.NewClient(nil).WithAuthToken(c.Viper.GetString("token"))
 
-	exec := handlers.NewExecHandler()
+	exec := handlers.NewExecHandler(c)
 	tmpl := handlers.NewTemplateHandler(c)
 	repo := handlers.NewRepositoryHandler(client, c)
 	secret := handlers.NewSecretsHandler(client, c)
 	query := handlers.NewQueryHandler(client, c)
-	pkg := handlers.NewPackageHandler(query, exec)
+	pkg := handlers.NewPackageHandler(query)
 
 	return &gitHandler{
 		tmplHandler:    tmpl,
 		repoHandler:    repo,
 		secretsHandler: secret,
+		varsHandler:    handlers.NewVariablesHandler(client, c),
 		pkgHandler:     pkg,
 		execHandler:    exec,
+		historyHandler: handlers.NewHistoryHandler(exec),
 	}
 }
 
@@ -55,6 +61,10 @@ func (git *gitHandler) Secrets() handlers.SecretsHandler {
 	return git.secretsHandler
 }
 
+func (git *gitHandler) Variables() handlers.VariablesHandler {
+	return git.varsHandler
+}
+
 func (git *gitHandler) Templates() handlers.TemplateHandler {
 	return git.tmplHandler
 }
@@ -62,3 +72,7 @@ func (git *gitHandler) Templates() handlers.TemplateHandler {
 func (git *gitHandler) Exec() handlers.ExecHandler {
 	return git.execHandler
 }
+
+func (git *gitHandler) History() handlers.HistoryHandler {
+	return git.historyHandler
+}
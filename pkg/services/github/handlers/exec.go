@@ -3,10 +3,9 @@ package handlers
 import (
 	"bytes"
 	"context"
-	"fmt"
+	"github.com/alpha-omega-corp/services/types"
 	clientv3 "go.etcd.io/etcd/client/v3"
 	"os"
-	"os/exec"
 	"path/filepath"
 	"strings"
 	"time"
@@ -16,17 +15,21 @@ type ExecHandler interface {
 	KvsGet(ctx context.Context, key string) (*clientv3.GetResponse, error)
 	KvsPut(ctx context.Context, key string, value string) (err error)
 	KvsDelete(ctx context.Context, key string) (err error)
+	KvsList(ctx context.Context, prefix string) (*clientv3.GetResponse, error)
+	KvsCreate(ctx context.Context, key string, value string) (bool, error)
 	WriteConfig(template *bytes.Buffer) error
-	RunMakefile(path string, act string) error
+	WriteVariables(template *bytes.Buffer) error
+	Client() *clientv3.Client
 }
 
 type execHandler struct {
 	ExecHandler
 
 	etcdClient *clientv3.Client
+	actDir     string
 }
 
-func NewExecHandler() ExecHandler {
+func NewExecHandler(c types.Config) ExecHandler {
 	config := clientv3.Config{
 		Endpoints:   []string{"localhost:2379"},
 		DialTimeout: 5 * time.Second,
@@ -39,9 +42,15 @@ func NewExecHandler() ExecHandler {
 
 	return &execHandler{
 		etcdClient: cli,
+		actDir:     c.Viper.GetString("actConfig"),
 	}
 }
 
+// Client is shared with the other services persisting to etcd
+func (h *execHandler) Client() *clientv3.Client {
+	return h.etcdClient
+}
+
 func (h *execHandler) KvsGet(ctx context.Context, key string) (*clientv3.GetResponse, error) {
 	return h.etcdClient.Get(ctx, key)
 }
@@ -64,25 +73,43 @@ func (h *execHandler) KvsDelete(ctx context.Context, key string) (err error) {
 	return
 }
 
-func (h *execHandler) WriteConfig(template *bytes.Buffer) error {
-	return os.WriteFile("/home/nanstis/.config/act/.secrets", template.Bytes(), 0644)
+func (h *execHandler) KvsList(ctx context.Context, prefix string) (*clientv3.GetResponse, error) {
+	return h.etcdClient.Get(ctx, prefix, clientv3.WithPrefix(), clientv3.WithSort(clientv3.SortByKey, clientv3.SortAscend))
 }
 
-func (h *execHandler) RunMakefile(path string, act string) error {
-	path, err := filepath.Abs(path)
+// KvsCreate only writes the key if it does not exist yet, it reports whether it was written
+func (h *execHandler) KvsCreate(ctx context.Context, key string, value string) (bool, error) {
+	res, err := h.etcdClient.Txn(ctx).
+		If(clientv3.Compare(clientv3.CreateRevision(key), "=", 0)).
+		Then(clientv3.OpPut(key, value)).
+		Commit()
 	if err != nil {
-		return err
+		return false, err
 	}
 
-	cmd := exec.Command("make", act)
-	cmd.Dir = path
+	return res.Succeeded, nil
+}
 
-	res, err := cmd.Output()
-	if err != nil {
-		return err
-	}
+func (h *execHandler) WriteConfig(template *bytes.Buffer) error {
+	return h.writeAct(".secrets", template)
+}
+
+// act reads variables from its own file next to the secrets
+func (h *execHandler) WriteVariables(template *bytes.Buffer) error {
+	return h.writeAct(".vars", template)
+}
+
+// writeAct writes to the configured act directory, act looks in ~/.config/act by default
+func (h *execHandler) writeAct(name string, template *bytes.Buffer) error {
+	dir := h.actDir
+	if dir == "" {
+		home, err := os.UserHomeDir()
+		if err != nil {
+			return err
+		}
 
-	fmt.Print(string(res))
+		dir = filepath.Join(home, ".config", "act")
+	}
 
-	return nil
+	return os.WriteFile(filepath.Join(dir, name), template.Bytes(), 0644)
 }
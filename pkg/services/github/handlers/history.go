@@ -0,0 +1,111 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	pkgTypes "github.com/alpha-omega-corp/github-svc/pkg/types"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	SecretCreated    = "create"
+	SecretDeleted    = "delete"
+	SecretRolledBack = "rollback"
+)
+
+const historyPrefix = "history/"
+
+type HistoryHandler interface {
+	Record(ctx context.Context, name string, version *pkgTypes.SecretVersion) (*pkgTypes.SecretVersion, error)
+	GetAll(ctx context.Context, name string) ([]*pkgTypes.SecretVersion, error)
+	Get(ctx context.Context, name string, version int64) (*pkgTypes.SecretVersion, error)
+}
+
+type historyHandler struct {
+	HistoryHandler
+
+	execHandler ExecHandler
+}
+
+func NewHistoryHandler(exec ExecHandler) HistoryHandler {
+	return &historyHandler{
+		execHandler: exec,
+	}
+}
+
+// Record appends a version to the history of a secret, concurrent writers retry on the next version
+func (h *historyHandler) Record(ctx context.Context, name string, version *pkgTypes.SecretVersion) (*pkgTypes.SecretVersion, error) {
+	for {
+		versions, err := h.GetAll(ctx, name)
+		if err != nil {
+			return nil, err
+		}
+
+		version.Version = 1
+		if len(versions) != 0 {
+			version.Version = versions[len(versions)-1].Version + 1
+		}
+
+		version.Created = time.Now()
+
+		value, err := json.Marshal(version)
+		if err != nil {
+			return nil, err
+		}
+
+		created, err := h.execHandler.KvsCreate(ctx, historyKey(name, version.Version), string(value))
+		if err != nil {
+			return nil, err
+		}
+
+		if created {
+			return version, nil
+		}
+	}
+}
+
+func (h *historyHandler) GetAll(ctx context.Context, name string) ([]*pkgTypes.SecretVersion, error) {
+	res, err := h.execHandler.KvsList(ctx, historyPrefix+strings.ToLower(name)+"/")
+	if err != nil {
+		return nil, err
+	}
+
+	versions := make([]*pkgTypes.SecretVersion, len(res.Kvs))
+	for index, kv := range res.Kvs {
+		version := new(pkgTypes.SecretVersion)
+		if err := json.Unmarshal(kv.Value, version); err != nil {
+			return nil, err
+		}
+
+		versions[index] = version
+	}
+
+	return versions, nil
+}
+
+func (h *historyHandler) Get(ctx context.Context, name string, version int64) (*pkgTypes.SecretVersion, error) {
+	res, err := h.execHandler.KvsGet(ctx, historyKey(name, version))
+	if err != nil {
+		return nil, err
+	}
+
+	if len(res.Kvs) == 0 {
+		return nil, errors.New("secret " + name + " has no version " + strconv.FormatInt(version, 10))
+	}
+
+	secretVersion := new(pkgTypes.SecretVersion)
+	if err := json.Unmarshal(res.Kvs[0].Value, secretVersion); err != nil {
+		return nil, err
+	}
+
+	return secretVersion, nil
+}
+
+func historyKey(name string, version int64) string {
+	// versions are zero padded so that etcd sorts them numerically
+	return fmt.Sprintf("%s%s/%020d", historyPrefix, strings.ToLower(name), version)
+}
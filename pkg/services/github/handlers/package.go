@@ -10,31 +10,20 @@ import (
 type PackageHandler interface {
 	GetVersions(name string) ([]pkgTypes.GitPackageVersion, error)
 	GetVersion(name string, vId int64) (*pkgTypes.GitPackageVersion, error)
-	Push(path string) (err error)
 	Delete(name string, vId *int64) error
 }
 
 type packageHandler struct {
 	PackageHandler
-	execHandler  ExecHandler
 	queryHandler QueryHandler
 }
 
-func NewPackageHandler(query QueryHandler, exec ExecHandler) PackageHandler {
+func NewPackageHandler(query QueryHandler) PackageHandler {
 	return &packageHandler{
 		queryHandler: query,
-		execHandler:  exec,
 	}
 }
 
-func (h *packageHandler) Push(path string) (err error) {
-	err = h.execHandler.RunMakefile(path, "create")
-	err = h.execHandler.RunMakefile(path, "tag")
-	err = h.execHandler.RunMakefile(path, "push")
-
-	return
-}
-
 func (h *packageHandler) GetVersions(name string) ([]pkgTypes.GitPackageVersion, error) {
 	res, err := h.queryHandler.query("GET", "packages/container/"+name+"/versions")
 	if err != nil {
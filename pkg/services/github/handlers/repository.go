@@ -2,8 +2,12 @@ package handlers
 
 import (
 	"context"
+	"errors"
 	"github.com/alpha-omega-corp/services/types"
 	"github.com/google/go-github/v56/github"
+	"path"
+	"strconv"
+	"strings"
 )
 
 type Content struct {
@@ -12,9 +16,11 @@ type Content struct {
 	Response *github.Response
 }
 
+// PackageFile is a file of a package version, its name is relative to the version directory and its mode is a git file mode
 type PackageFile struct {
 	Name    string
 	SHA     string
+	Mode    int64
 	Content []byte
 }
 
@@ -50,29 +56,61 @@ func (h *repositoryHandler) GetAll(ctx context.Context) ([]*github.Repository, e
 	return packages, nil
 }
 
+// GetPackageFiles walks the directory of a package version recursively, the git tree keeps the file modes
 func (h *repositoryHandler) GetPackageFiles(ctx context.Context, name string) ([]*PackageFile, error) {
-	_, dir, _, err := h.client.Repositories.GetContents(ctx, h.org, "container-images", name, nil)
+	parent, base := path.Split(name)
+	_, dir, _, err := h.client.Repositories.GetContents(ctx, h.org, "container-images", strings.TrimSuffix(parent, "/"), nil)
 	if err != nil {
 		return nil, err
 	}
 
-	files := make([]*PackageFile, len(dir))
-	for index, file := range dir {
-		f, _, _, err := h.client.Repositories.GetContents(ctx, h.org, "container-images", name+"/"+*file.Name, nil)
+	var entry *github.RepositoryContent
+	for _, item := range dir {
+		if item.GetName() == base {
+			entry = item
+		}
+	}
+
+	if entry == nil {
+		return nil, errors.New("no package files found at " + name)
+	}
+
+	// plain files are not package versions
+	if entry.GetType() != "dir" {
+		return nil, nil
+	}
+
+	tree, _, err := h.client.Git.GetTree(ctx, h.org, "container-images", entry.GetSHA(), true)
+	if err != nil {
+		return nil, err
+	}
+
+	if tree.GetTruncated() {
+		return nil, errors.New("too many package files at " + name)
+	}
+
+	var files []*PackageFile
+	for _, item := range tree.Entries {
+		if item.GetType() != "blob" {
+			continue
+		}
+
+		mode, err := strconv.ParseInt(item.GetMode(), 8, 64)
 		if err != nil {
 			return nil, err
 		}
 
-		content, err := f.GetContent()
+		content, _, err := h.client.Git.GetBlobRaw(ctx, h.org, "container-images", item.GetSHA())
 		if err != nil {
 			return nil, err
 		}
 
-		files[index] = &PackageFile{
-			SHA:     *file.SHA,
-			Name:    *file.Name,
-			Content: []byte(content),
-		}
+		files = append(files, &PackageFile{
+			Name:    item.GetPath(),
+			SHA:     item.GetSHA(),
+			Mode:    mode,
+			Content: content,
+		})
 	}
 
 	return files, nil
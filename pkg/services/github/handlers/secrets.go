@@ -4,11 +4,14 @@ import (
 	"context"
 	"crypto/rand"
 	"encoding/base64"
+	"errors"
 	"fmt"
+	pkgTypes "github.com/alpha-omega-corp/github-svc/pkg/types"
 	"github.com/alpha-omega-corp/services/types"
 	"github.com/google/go-github/v56/github"
 	"golang.org/x/crypto/blake2b"
 	"golang.org/x/crypto/nacl/box"
+	"strings"
 )
 
 // Encrypt
@@ -19,12 +22,21 @@ const (
 
 var generateKey = box.GenerateKey
 
+const (
+	ScopeOrg         = "org"
+	ScopeRepository  = "repository"
+	ScopeEnvironment = "environment"
+)
+
 type SecretsHandler interface {
-	GetAll(ctx context.Context) ([]*github.Secret, error)
-	Create(ctx context.Context, name string, content []byte) error
-	Delete(ctx context.Context, name string) error
-	GetKey(ctx context.Context) (*github.PublicKey, error)
+	GetAll(ctx context.Context, scope pkgTypes.SecretScope) ([]*github.Secret, error)
+	Create(ctx context.Context, scope pkgTypes.SecretScope, name string, content []byte, visibility *pkgTypes.SecretVisibility) (*pkgTypes.SecretVisibility, error)
+	Delete(ctx context.Context, scope pkgTypes.SecretScope, name string) error
+	GetKey(ctx context.Context, scope pkgTypes.SecretScope) (*github.PublicKey, error)
 	Encrypt(recipientPublicKey string, content string) (string, error)
+	GetRepositories(ctx context.Context, store string, name string) ([]string, error)
+	AddRepository(ctx context.Context, store string, name string, repo string) error
+	RemoveRepository(ctx context.Context, store string, name string, repo string) error
 }
 
 type secretsHandler struct {
@@ -41,8 +53,24 @@ func NewSecretsHandler(cli *github.Client, c types.Config) SecretsHandler {
 	}
 }
 
-func (h *secretsHandler) GetAll(ctx context.Context) ([]*github.Secret, error) {
-	data, _, err := h.client.Actions.ListOrgSecrets(ctx, h.org, &github.ListOptions{})
+func (h *secretsHandler) GetAll(ctx context.Context, scope pkgTypes.SecretScope) ([]*github.Secret, error) {
+	var data *github.Secrets
+	var err error
+
+	switch scope.Type {
+	case ScopeRepository:
+		data, _, err = h.client.Actions.ListRepoSecrets(ctx, h.org, scope.Repository, &github.ListOptions{})
+	case ScopeEnvironment:
+		repoId, idErr := repositoryId(ctx, h.client, h.org, scope.Repository)
+		if idErr != nil {
+			return nil, idErr
+		}
+
+		data, _, err = h.client.Actions.ListEnvSecrets(ctx, repoId, scope.Environment, &github.ListOptions{})
+	default:
+		data, err = h.orgSecrets(ctx, scope.Store)
+	}
+
 	if err != nil {
 		return nil, err
 	}
@@ -50,32 +78,76 @@ func (h *secretsHandler) GetAll(ctx context.Context) ([]*github.Secret, error) {
 	return data.Secrets, nil
 }
 
-func (h *secretsHandler) Create(ctx context.Context, name string, content []byte) error {
-	key, err := h.GetKey(ctx)
+// Create encrypts and stores a secret, org secrets without a visibility keep the one they already have.
+// The visibility applied to org secrets is returned.
+func (h *secretsHandler) Create(ctx context.Context, scope pkgTypes.SecretScope, name string, content []byte, visibility *pkgTypes.SecretVisibility) (*pkgTypes.SecretVisibility, error) {
+	key, err := h.GetKey(ctx, scope)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	encodedString, err := h.Encrypt(key.GetKey(), string(content))
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	_, err = h.client.Actions.CreateOrUpdateOrgSecret(ctx, h.org, &github.EncryptedSecret{
+	secret := &github.EncryptedSecret{
 		Name:           name,
 		KeyID:          key.GetKeyID(),
 		EncryptedValue: encodedString,
-		Visibility:     "all",
-	})
+	}
+
+	switch scope.Type {
+	case ScopeRepository:
+		_, err = h.client.Actions.CreateOrUpdateRepoSecret(ctx, h.org, scope.Repository, secret)
+	case ScopeEnvironment:
+		repoId, idErr := repositoryId(ctx, h.client, h.org, scope.Repository)
+		if idErr != nil {
+			return nil, idErr
+		}
+
+		_, err = h.client.Actions.CreateOrUpdateEnvSecret(ctx, repoId, scope.Environment, secret)
+	default:
+		if visibility == nil {
+			visibility, err = h.currentVisibility(ctx, scope.Store, name)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		secret.Visibility = visibility.Visibility
+		secret.SelectedRepositoryIDs, err = repositoryIds(ctx, h.client, h.org, visibility.Repositories)
+		if err != nil {
+			return nil, err
+		}
+
+		err = h.putOrgSecret(ctx, scope.Store, secret)
+	}
+
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	return nil
+	return visibility, nil
 }
 
-func (h *secretsHandler) Delete(ctx context.Context, name string) error {
-	_, err := h.client.Actions.DeleteOrgSecret(ctx, h.org, name)
+func (h *secretsHandler) Delete(ctx context.Context, scope pkgTypes.SecretScope, name string) error {
+	var err error
+
+	switch scope.Type {
+	case ScopeRepository:
+		_, err = h.client.Actions.DeleteRepoSecret(ctx, h.org, scope.Repository, name)
+	case ScopeEnvironment:
+		repoId, idErr := repositoryId(ctx, h.client, h.org, scope.Repository)
+		if idErr != nil {
+			return idErr
+		}
+
+		_, err = h.client.Actions.DeleteEnvSecret(ctx, repoId, scope.Environment, name)
+	default:
+		err = h.deleteOrgSecret(ctx, scope.Store, name)
+	}
+
 	if err != nil {
 		return err
 	}
@@ -83,8 +155,25 @@ func (h *secretsHandler) Delete(ctx context.Context, name string) error {
 	return nil
 }
 
-func (h *secretsHandler) GetKey(ctx context.Context) (*github.PublicKey, error) {
-	data, _, err := h.client.Actions.GetOrgPublicKey(ctx, h.org)
+// GetKey returns the public key secrets of the scope must be encrypted with
+func (h *secretsHandler) GetKey(ctx context.Context, scope pkgTypes.SecretScope) (*github.PublicKey, error) {
+	var data *github.PublicKey
+	var err error
+
+	switch scope.Type {
+	case ScopeRepository:
+		data, _, err = h.client.Actions.GetRepoPublicKey(ctx, h.org, scope.Repository)
+	case ScopeEnvironment:
+		repoId, idErr := repositoryId(ctx, h.client, h.org, scope.Repository)
+		if idErr != nil {
+			return nil, idErr
+		}
+
+		data, _, err = h.client.Actions.GetEnvPublicKey(ctx, repoId, scope.Environment)
+	default:
+		data, err = h.orgPublicKey(ctx, scope.Store)
+	}
+
 	if err != nil {
 		return nil, err
 	}
@@ -92,6 +181,16 @@ func (h *secretsHandler) GetKey(ctx context.Context) (*github.PublicKey, error)
 	return data, nil
 }
 
+// environment endpoints address repositories by id instead of name
+func repositoryId(ctx context.Context, client *github.Client, org string, repo string) (int, error) {
+	data, _, err := client.Repositories.Get(ctx, org, repo)
+	if err != nil {
+		return 0, err
+	}
+
+	return int(data.GetID()), nil
+}
+
 func (h *secretsHandler) Encrypt(recipientPublicKey string, content string) (string, error) {
 	// decode the provided public key from base64
 	recipientKey := new([keySize]byte)
@@ -133,3 +232,49 @@ func (h *secretsHandler) Encrypt(recipientPublicKey string, content string) (str
 	// base64-encode the final output
 	return base64.StdEncoding.EncodeToString(out), nil
 }
+
+// SecretKey namespaces the etcd mirror key of a secret, org secrets keep their historical unprefixed key
+func SecretKey(scope pkgTypes.SecretScope, name string) string {
+	if scope.Store == StoreDependabot || scope.Store == StoreCodespaces {
+		return strings.ToLower(scope.Store + ":" + name)
+	}
+
+	switch scope.Type {
+	case ScopeRepository:
+		return strings.ToLower("repository:" + scope.Repository + "/" + name)
+	case ScopeEnvironment:
+		return strings.ToLower("environment:" + scope.Repository + ":" + scope.Environment + "/" + name)
+	default:
+		return strings.ToLower(name)
+	}
+}
+
+// ValidateScope checks that repository and environment scopes name their target
+func ValidateScope(scope pkgTypes.SecretScope) error {
+	switch scope.Store {
+	case StoreActions:
+	case StoreDependabot, StoreCodespaces:
+		if scope.Type != ScopeOrg {
+			return errors.New(scope.Store + " secrets can only be managed at the org level")
+		}
+	default:
+		return errors.New("unknown secret store " + scope.Store)
+	}
+
+	switch scope.Type {
+	case ScopeOrg:
+		return nil
+	case ScopeRepository:
+		if scope.Repository == "" {
+			return errors.New("repository secrets require a repository")
+		}
+	case ScopeEnvironment:
+		if scope.Repository == "" || scope.Environment == "" {
+			return errors.New("environment secrets require a repository and an environment")
+		}
+	default:
+		return errors.New("unknown secret scope " + scope.Type)
+	}
+
+	return nil
+}
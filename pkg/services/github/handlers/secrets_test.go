@@ -0,0 +1,122 @@
+package handlers
+
+import (
+	pkgTypes "github.com/alpha-omega-corp/github-svc/pkg/types"
+	"strings"
+	"testing"
+)
+
+func TestSecretKey(t *testing.T) {
+	tests := []struct {
+		name   string
+		scope  pkgTypes.SecretScope
+		secret string
+		want   string
+	}{
+		{
+			name:   "org secrets keep their legacy key",
+			scope:  pkgTypes.SecretScope{Type: ScopeOrg, Store: StoreActions},
+			secret: "DB_PASSWORD",
+			want:   "db_password",
+		},
+		{
+			name:   "repository",
+			scope:  pkgTypes.SecretScope{Type: ScopeRepository, Repository: "Api", Store: StoreActions},
+			secret: "TOKEN",
+			want:   "repository:api/token",
+		},
+		{
+			name:   "environment",
+			scope:  pkgTypes.SecretScope{Type: ScopeEnvironment, Repository: "api", Environment: "Prod", Store: StoreActions},
+			secret: "TOKEN",
+			want:   "environment:api:prod/token",
+		},
+		{
+			name:   "dependabot",
+			scope:  pkgTypes.SecretScope{Type: ScopeOrg, Store: StoreDependabot},
+			secret: "NPM_TOKEN",
+			want:   "dependabot:npm_token",
+		},
+		{
+			name:   "codespaces",
+			scope:  pkgTypes.SecretScope{Type: ScopeOrg, Store: StoreCodespaces},
+			secret: "NPM_TOKEN",
+			want:   "codespaces:npm_token",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := SecretKey(tt.scope, tt.secret); got != tt.want {
+				t.Fatalf("expected %q, got %q", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestValidateScope(t *testing.T) {
+	tests := []struct {
+		name  string
+		scope pkgTypes.SecretScope
+		err   string
+	}{
+		{
+			name:  "org",
+			scope: pkgTypes.SecretScope{Type: ScopeOrg, Store: StoreActions},
+		},
+		{
+			name:  "repository",
+			scope: pkgTypes.SecretScope{Type: ScopeRepository, Repository: "api", Store: StoreActions},
+		},
+		{
+			name:  "repository without a repository",
+			scope: pkgTypes.SecretScope{Type: ScopeRepository, Store: StoreActions},
+			err:   "require a repository",
+		},
+		{
+			name:  "environment",
+			scope: pkgTypes.SecretScope{Type: ScopeEnvironment, Repository: "api", Environment: "prod", Store: StoreActions},
+		},
+		{
+			name:  "environment without an environment",
+			scope: pkgTypes.SecretScope{Type: ScopeEnvironment, Repository: "api", Store: StoreActions},
+			err:   "require a repository and an environment",
+		},
+		{
+			name:  "dependabot at the org level",
+			scope: pkgTypes.SecretScope{Type: ScopeOrg, Store: StoreDependabot},
+		},
+		{
+			name:  "dependabot at the repository level",
+			scope: pkgTypes.SecretScope{Type: ScopeRepository, Repository: "api", Store: StoreDependabot},
+			err:   "only be managed at the org level",
+		},
+		{
+			name:  "unknown store",
+			scope: pkgTypes.SecretScope{Type: ScopeOrg, Store: "vault"},
+			err:   "unknown secret store",
+		},
+		{
+			name:  "unknown scope",
+			scope: pkgTypes.SecretScope{Type: "team", Store: StoreActions},
+			err:   "unknown secret scope",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateScope(tt.scope)
+			if tt.err == "" {
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+
+				return
+			}
+
+			if err == nil || !strings.Contains(err.Error(), tt.err) {
+				t.Fatalf("expected an error containing %q, got %v", tt.err, err)
+			}
+		})
+	}
+}
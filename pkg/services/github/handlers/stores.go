@@ -0,0 +1,138 @@
+package handlers
+
+import (
+	"context"
+	"github.com/google/go-github/v56/github"
+)
+
+const (
+	StoreActions    = "actions"
+	StoreDependabot = "dependabot"
+	StoreCodespaces = "codespaces"
+)
+
+// dependabot and codespaces secrets are only managed at the org level, they share the actions payloads
+func (h *secretsHandler) orgSecrets(ctx context.Context, store string) (*github.Secrets, error) {
+	var data *github.Secrets
+	var err error
+
+	switch store {
+	case StoreDependabot:
+		data, _, err = h.client.Dependabot.ListOrgSecrets(ctx, h.org, &github.ListOptions{})
+	case StoreCodespaces:
+		data, _, err = h.client.Codespaces.ListOrgSecrets(ctx, h.org, &github.ListOptions{})
+	default:
+		data, _, err = h.client.Actions.ListOrgSecrets(ctx, h.org, &github.ListOptions{})
+	}
+
+	return data, err
+}
+
+func (h *secretsHandler) orgSecret(ctx context.Context, store string, name string) (*github.Secret, error) {
+	var data *github.Secret
+	var err error
+
+	switch store {
+	case StoreDependabot:
+		data, _, err = h.client.Dependabot.GetOrgSecret(ctx, h.org, name)
+	case StoreCodespaces:
+		data, _, err = h.client.Codespaces.GetOrgSecret(ctx, h.org, name)
+	default:
+		data, _, err = h.client.Actions.GetOrgSecret(ctx, h.org, name)
+	}
+
+	return data, err
+}
+
+func (h *secretsHandler) orgPublicKey(ctx context.Context, store string) (*github.PublicKey, error) {
+	var data *github.PublicKey
+	var err error
+
+	switch store {
+	case StoreDependabot:
+		data, _, err = h.client.Dependabot.GetOrgPublicKey(ctx, h.org)
+	case StoreCodespaces:
+		data, _, err = h.client.Codespaces.GetOrgPublicKey(ctx, h.org)
+	default:
+		data, _, err = h.client.Actions.GetOrgPublicKey(ctx, h.org)
+	}
+
+	return data, err
+}
+
+func (h *secretsHandler) putOrgSecret(ctx context.Context, store string, secret *github.EncryptedSecret) error {
+	var err error
+
+	switch store {
+	case StoreDependabot:
+		_, err = h.client.Dependabot.CreateOrUpdateOrgSecret(ctx, h.org, &github.DependabotEncryptedSecret{
+			Name:                  secret.Name,
+			KeyID:                 secret.KeyID,
+			EncryptedValue:        secret.EncryptedValue,
+			Visibility:            secret.Visibility,
+			SelectedRepositoryIDs: github.DependabotSecretsSelectedRepoIDs(secret.SelectedRepositoryIDs),
+		})
+	case StoreCodespaces:
+		_, err = h.client.Codespaces.CreateOrUpdateOrgSecret(ctx, h.org, secret)
+	default:
+		_, err = h.client.Actions.CreateOrUpdateOrgSecret(ctx, h.org, secret)
+	}
+
+	return err
+}
+
+func (h *secretsHandler) deleteOrgSecret(ctx context.Context, store string, name string) error {
+	var err error
+
+	switch store {
+	case StoreDependabot:
+		_, err = h.client.Dependabot.DeleteOrgSecret(ctx, h.org, name)
+	case StoreCodespaces:
+		_, err = h.client.Codespaces.DeleteOrgSecret(ctx, h.org, name)
+	default:
+		_, err = h.client.Actions.DeleteOrgSecret(ctx, h.org, name)
+	}
+
+	return err
+}
+
+func (h *secretsHandler) selectedRepos(ctx context.Context, store string, name string, opts *github.ListOptions) (*github.SelectedReposList, *github.Response, error) {
+	switch store {
+	case StoreDependabot:
+		return h.client.Dependabot.ListSelectedReposForOrgSecret(ctx, h.org, name, opts)
+	case StoreCodespaces:
+		return h.client.Codespaces.ListSelectedReposForOrgSecret(ctx, h.org, name, opts)
+	default:
+		return h.client.Actions.ListSelectedReposForOrgSecret(ctx, h.org, name, opts)
+	}
+}
+
+func (h *secretsHandler) addSelectedRepo(ctx context.Context, store string, name string, repo *github.Repository) error {
+	var err error
+
+	switch store {
+	case StoreDependabot:
+		_, err = h.client.Dependabot.AddSelectedRepoToOrgSecret(ctx, h.org, name, repo)
+	case StoreCodespaces:
+		_, err = h.client.Codespaces.AddSelectedRepoToOrgSecret(ctx, h.org, name, repo)
+	default:
+		_, err = h.client.Actions.AddSelectedRepoToOrgSecret(ctx, h.org, name, repo)
+	}
+
+	return err
+}
+
+func (h *secretsHandler) removeSelectedRepo(ctx context.Context, store string, name string, repo *github.Repository) error {
+	var err error
+
+	switch store {
+	case StoreDependabot:
+		_, err = h.client.Dependabot.RemoveSelectedRepoFromOrgSecret(ctx, h.org, name, repo)
+	case StoreCodespaces:
+		_, err = h.client.Codespaces.RemoveSelectedRepoFromOrgSecret(ctx, h.org, name, repo)
+	default:
+		_, err = h.client.Actions.RemoveSelectedRepoFromOrgSecret(ctx, h.org, name, repo)
+	}
+
+	return err
+}
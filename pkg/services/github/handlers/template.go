@@ -18,7 +18,6 @@ var (
 )
 
 type TemplateHandler interface {
-	CreateMakefile(pkgName string, pkgTag string) (*bytes.Buffer, error)
 	CreateDockerfile(pkgName string, pkgTag string, content []byte) (*bytes.Buffer, error)
 	CreateConfiguration(data map[string]string) (*bytes.Buffer, error)
 }
@@ -46,21 +45,6 @@ func NewTemplateHandler(c types.Config) TemplateHandler {
 	}
 }
 
-func (h *templateHandler) CreateMakefile(pkgName string, pkgTag string) (*bytes.Buffer, error) {
-	buf := &bytes.Buffer{}
-
-	if err := h.template.ExecuteTemplate(buf, "makefile.template", &pkgTypes.CreateMakefileDto{
-		Registry: h.config.Viper.GetString("registry"),
-		OrgName:  h.config.Viper.GetString("name"),
-		Name:     pkgName,
-		Tag:      pkgTag,
-	}); err != nil {
-		return nil, err
-	}
-
-	return buf, nil
-}
-
 func (h *templateHandler) CreateDockerfile(pkgName string, pkgTag string, content []byte) (*bytes.Buffer, error) {
 	buf := &bytes.Buffer{}
 
@@ -0,0 +1,208 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	pkgTypes "github.com/alpha-omega-corp/github-svc/pkg/types"
+	"github.com/alpha-omega-corp/services/types"
+	"github.com/google/go-github/v56/github"
+)
+
+// variables are addressed with the same scopes as the actions secrets
+type VariablesHandler interface {
+	GetAll(ctx context.Context, scope pkgTypes.SecretScope) ([]*github.ActionsVariable, error)
+	Get(ctx context.Context, scope pkgTypes.SecretScope, name string) (*github.ActionsVariable, error)
+	Create(ctx context.Context, scope pkgTypes.SecretScope, name string, value string, visibility *pkgTypes.SecretVisibility) error
+	Update(ctx context.Context, scope pkgTypes.SecretScope, name string, value string, visibility *pkgTypes.SecretVisibility) error
+	Delete(ctx context.Context, scope pkgTypes.SecretScope, name string) error
+}
+
+type variablesHandler struct {
+	VariablesHandler
+
+	client *github.Client
+	org    string
+}
+
+func NewVariablesHandler(cli *github.Client, c types.Config) VariablesHandler {
+	return &variablesHandler{
+		client: cli,
+		org:    c.Viper.GetString("name"),
+	}
+}
+
+func (h *variablesHandler) GetAll(ctx context.Context, scope pkgTypes.SecretScope) ([]*github.ActionsVariable, error) {
+	var data *github.ActionsVariables
+	var err error
+
+	switch scope.Type {
+	case ScopeRepository:
+		data, _, err = h.client.Actions.ListRepoVariables(ctx, h.org, scope.Repository, &github.ListOptions{})
+	case ScopeEnvironment:
+		repoId, idErr := repositoryId(ctx, h.client, h.org, scope.Repository)
+		if idErr != nil {
+			return nil, idErr
+		}
+
+		data, _, err = h.client.Actions.ListEnvVariables(ctx, repoId, scope.Environment, &github.ListOptions{})
+	default:
+		data, _, err = h.client.Actions.ListOrgVariables(ctx, h.org, &github.ListOptions{})
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return data.Variables, nil
+}
+
+func (h *variablesHandler) Get(ctx context.Context, scope pkgTypes.SecretScope, name string) (*github.ActionsVariable, error) {
+	var data *github.ActionsVariable
+	var err error
+
+	switch scope.Type {
+	case ScopeRepository:
+		data, _, err = h.client.Actions.GetRepoVariable(ctx, h.org, scope.Repository, name)
+	case ScopeEnvironment:
+		repoId, idErr := repositoryId(ctx, h.client, h.org, scope.Repository)
+		if idErr != nil {
+			return nil, idErr
+		}
+
+		data, _, err = h.client.Actions.GetEnvVariable(ctx, repoId, scope.Environment, name)
+	default:
+		data, _, err = h.client.Actions.GetOrgVariable(ctx, h.org, name)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return data, nil
+}
+
+// Create stores a variable, org variables without a visibility are shared with every repository
+func (h *variablesHandler) Create(ctx context.Context, scope pkgTypes.SecretScope, name string, value string, visibility *pkgTypes.SecretVisibility) error {
+	variable := &github.ActionsVariable{
+		Name:  name,
+		Value: value,
+	}
+
+	var err error
+
+	switch scope.Type {
+	case ScopeRepository:
+		_, err = h.client.Actions.CreateRepoVariable(ctx, h.org, scope.Repository, variable)
+	case ScopeEnvironment:
+		repoId, idErr := repositoryId(ctx, h.client, h.org, scope.Repository)
+		if idErr != nil {
+			return idErr
+		}
+
+		_, err = h.client.Actions.CreateEnvVariable(ctx, repoId, scope.Environment, variable)
+	default:
+		if visibility == nil {
+			visibility = &pkgTypes.SecretVisibility{Visibility: VisibilityAll}
+		}
+
+		if err := h.setVisibility(ctx, variable, visibility); err != nil {
+			return err
+		}
+
+		_, err = h.client.Actions.CreateOrgVariable(ctx, h.org, variable)
+	}
+
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Update changes the value, org variables without a visibility keep the one they already have
+func (h *variablesHandler) Update(ctx context.Context, scope pkgTypes.SecretScope, name string, value string, visibility *pkgTypes.SecretVisibility) error {
+	variable := &github.ActionsVariable{
+		Name:  name,
+		Value: value,
+	}
+
+	var err error
+
+	switch scope.Type {
+	case ScopeRepository:
+		_, err = h.client.Actions.UpdateRepoVariable(ctx, h.org, scope.Repository, variable)
+	case ScopeEnvironment:
+		repoId, idErr := repositoryId(ctx, h.client, h.org, scope.Repository)
+		if idErr != nil {
+			return idErr
+		}
+
+		_, err = h.client.Actions.UpdateEnvVariable(ctx, repoId, scope.Environment, variable)
+	default:
+		if visibility != nil {
+			if err := h.setVisibility(ctx, variable, visibility); err != nil {
+				return err
+			}
+		}
+
+		_, err = h.client.Actions.UpdateOrgVariable(ctx, h.org, variable)
+	}
+
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (h *variablesHandler) Delete(ctx context.Context, scope pkgTypes.SecretScope, name string) error {
+	var err error
+
+	switch scope.Type {
+	case ScopeRepository:
+		_, err = h.client.Actions.DeleteRepoVariable(ctx, h.org, scope.Repository, name)
+	case ScopeEnvironment:
+		repoId, idErr := repositoryId(ctx, h.client, h.org, scope.Repository)
+		if idErr != nil {
+			return idErr
+		}
+
+		_, err = h.client.Actions.DeleteEnvVariable(ctx, repoId, scope.Environment, name)
+	default:
+		_, err = h.client.Actions.DeleteOrgVariable(ctx, h.org, name)
+	}
+
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (h *variablesHandler) setVisibility(ctx context.Context, variable *github.ActionsVariable, visibility *pkgTypes.SecretVisibility) error {
+	if err := ValidateVisibility(visibility); err != nil {
+		return err
+	}
+
+	variable.Visibility = github.String(visibility.Visibility)
+	if visibility.Visibility != VisibilitySelected {
+		return nil
+	}
+
+	ids, err := repositoryIds(ctx, h.client, h.org, visibility.Repositories)
+	if err != nil {
+		return err
+	}
+
+	variable.SelectedRepositoryIDs = &ids
+	return nil
+}
+
+// ValidateVariableScope rejects the dependabot and codespaces stores, variables only exist for actions
+func ValidateVariableScope(scope pkgTypes.SecretScope) error {
+	if scope.Store != StoreActions {
+		return errors.New("variables can only be managed in the actions store")
+	}
+
+	return ValidateScope(scope)
+}
@@ -0,0 +1,111 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	pkgTypes "github.com/alpha-omega-corp/github-svc/pkg/types"
+	"github.com/google/go-github/v56/github"
+	"net/http"
+)
+
+const (
+	VisibilityAll      = "all"
+	VisibilityPrivate  = "private"
+	VisibilitySelected = "selected"
+)
+
+func (h *secretsHandler) GetRepositories(ctx context.Context, store string, name string) ([]string, error) {
+	opts := &github.ListOptions{PerPage: 100}
+
+	var repos []string
+	for {
+		data, res, err := h.selectedRepos(ctx, store, name, opts)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, repo := range data.Repositories {
+			repos = append(repos, repo.GetName())
+		}
+
+		if res.NextPage == 0 {
+			return repos, nil
+		}
+
+		opts.Page = res.NextPage
+	}
+}
+
+func (h *secretsHandler) AddRepository(ctx context.Context, store string, name string, repo string) error {
+	data, _, err := h.client.Repositories.Get(ctx, h.org, repo)
+	if err != nil {
+		return err
+	}
+
+	return h.addSelectedRepo(ctx, store, name, data)
+}
+
+func (h *secretsHandler) RemoveRepository(ctx context.Context, store string, name string, repo string) error {
+	data, _, err := h.client.Repositories.Get(ctx, h.org, repo)
+	if err != nil {
+		return err
+	}
+
+	return h.removeSelectedRepo(ctx, store, name, data)
+}
+
+// currentVisibility reads the visibility of an existing org secret, new secrets default to every repository
+func (h *secretsHandler) currentVisibility(ctx context.Context, store string, name string) (*pkgTypes.SecretVisibility, error) {
+	secret, err := h.orgSecret(ctx, store, name)
+	if err != nil {
+		var resErr *github.ErrorResponse
+		if errors.As(err, &resErr) && resErr.Response.StatusCode == http.StatusNotFound {
+			return &pkgTypes.SecretVisibility{Visibility: VisibilityAll}, nil
+		}
+
+		return nil, err
+	}
+
+	visibility := &pkgTypes.SecretVisibility{
+		Visibility: secret.Visibility,
+	}
+
+	if secret.Visibility == VisibilitySelected {
+		visibility.Repositories, err = h.GetRepositories(ctx, store, name)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return visibility, nil
+}
+
+func repositoryIds(ctx context.Context, client *github.Client, org string, repos []string) (github.SelectedRepoIDs, error) {
+	var ids github.SelectedRepoIDs
+	for _, repo := range repos {
+		id, err := repositoryId(ctx, client, org, repo)
+		if err != nil {
+			return nil, err
+		}
+
+		ids = append(ids, int64(id))
+	}
+
+	return ids, nil
+}
+
+// ValidateVisibility checks that only selected secrets name repositories
+func ValidateVisibility(visibility *pkgTypes.SecretVisibility) error {
+	switch visibility.Visibility {
+	case VisibilityAll, VisibilityPrivate:
+		if len(visibility.Repositories) != 0 {
+			return errors.New("repositories can only be selected with the selected visibility")
+		}
+	case VisibilitySelected:
+		return nil
+	default:
+		return errors.New("unknown secret visibility " + visibility.Visibility)
+	}
+
+	return nil
+}
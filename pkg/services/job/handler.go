@@ -0,0 +1,364 @@
+package job
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	pkgTypes "github.com/alpha-omega-corp/github-svc/pkg/types"
+	clientv3 "go.etcd.io/etcd/client/v3"
+	"sort"
+	"sync"
+	"time"
+)
+
+const (
+	StateRunning     = "running"
+	StateSucceeded   = "succeeded"
+	StateFailed      = "failed"
+	StateCancelled   = "cancelled"
+	StateInterrupted = "interrupted"
+)
+
+// only the tail of the logs is kept
+const maxLogs = 200
+
+// state changes are persisted right away, progress and logs at most once per flush interval
+const flushInterval = 2 * time.Second
+
+// completed jobs are forgotten once they are older than the retention
+const (
+	retention     = 24 * time.Hour
+	pruneInterval = time.Hour
+)
+
+type Reporter interface {
+	Progress(progress float64, message string)
+	Log(line string)
+	Result(result pkgTypes.JobResult)
+}
+
+type Handler interface {
+	Start(jobType string, run func(ctx context.Context, reporter Reporter) error) (*pkgTypes.Job, error)
+	Get(id string) (*pkgTypes.Job, error)
+	GetAll(jobType string, state string) []*pkgTypes.Job
+	Cancel(id string) error
+	Watch(ctx context.Context, id string, send func(job *pkgTypes.Job) error) error
+	Close() error
+}
+
+type entry struct {
+	job      pkgTypes.Job
+	cancel   context.CancelFunc
+	watchers map[chan struct{}]struct{}
+}
+
+type jobHandler struct {
+	Handler
+
+	store *store
+	ctx   context.Context
+	stop  context.CancelFunc
+	wg    sync.WaitGroup
+
+	mu   sync.Mutex
+	jobs map[string]*entry
+}
+
+// NewHandler persists the jobs with the given etcd client, it is not closed with the handler
+func NewHandler(client *clientv3.Client) (Handler, error) {
+	s := newStore(client)
+
+	jobs, err := s.loadAll()
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, stop := context.WithCancel(context.Background())
+	h := &jobHandler{
+		store: s,
+		ctx:   ctx,
+		stop:  stop,
+		jobs:  make(map[string]*entry, len(jobs)),
+	}
+
+	for _, job := range jobs {
+		if job.State != StateRunning && time.Since(job.Updated) > retention {
+			s.remove(job.Id)
+			continue
+		}
+
+		// the process running these jobs is gone, report them instead of leaving them running forever
+		if job.State == StateRunning {
+			job.State = StateInterrupted
+			job.Error = "the server stopped before the job completed"
+			job.Updated = time.Now()
+			s.save(job)
+		}
+
+		h.jobs[job.Id] = &entry{
+			job:      *job,
+			watchers: map[chan struct{}]struct{}{},
+		}
+	}
+
+	h.wg.Add(2)
+	go h.pruneLoop()
+	go h.flushLoop()
+
+	return h, nil
+}
+
+func (h *jobHandler) Start(jobType string, run func(ctx context.Context, reporter Reporter) error) (*pkgTypes.Job, error) {
+	id, err := newId()
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithCancel(h.ctx)
+	now := time.Now()
+
+	e := &entry{
+		job: pkgTypes.Job{
+			Id:      id,
+			Type:    jobType,
+			State:   StateRunning,
+			Created: now,
+			Updated: now,
+		},
+		cancel:   cancel,
+		watchers: map[chan struct{}]struct{}{},
+	}
+
+	h.mu.Lock()
+	h.jobs[id] = e
+	job := h.snapshot(e)
+	h.mu.Unlock()
+
+	h.store.save(job)
+
+	h.wg.Add(1)
+	go func() {
+		defer h.wg.Done()
+		defer cancel()
+
+		err := run(ctx, &reporter{handler: h, entry: e})
+		h.finish(e, ctx, err)
+	}()
+
+	return job, nil
+}
+
+func (h *jobHandler) Get(id string) (*pkgTypes.Job, error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	e, ok := h.jobs[id]
+	if !ok {
+		return nil, errors.New("unknown job " + id)
+	}
+
+	return h.snapshot(e), nil
+}
+
+// GetAll returns the jobs matching the optional type and state, newest first
+func (h *jobHandler) GetAll(jobType string, state string) []*pkgTypes.Job {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	var jobs []*pkgTypes.Job
+	for _, e := range h.jobs {
+		if jobType != "" && e.job.Type != jobType {
+			continue
+		}
+
+		if state != "" && e.job.State != state {
+			continue
+		}
+
+		jobs = append(jobs, h.snapshot(e))
+	}
+
+	sort.Slice(jobs, func(i, j int) bool {
+		return jobs[i].Created.After(jobs[j].Created)
+	})
+
+	return jobs
+}
+
+func (h *jobHandler) Cancel(id string) error {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	e, ok := h.jobs[id]
+	if !ok {
+		return errors.New("unknown job " + id)
+	}
+
+	if e.job.State != StateRunning {
+		return errors.New("job " + id + " is already " + e.job.State)
+	}
+
+	e.cancel()
+	return nil
+}
+
+// Watch sends the job on every update until it completes or the context is done
+func (h *jobHandler) Watch(ctx context.Context, id string, send func(job *pkgTypes.Job) error) error {
+	notify := make(chan struct{}, 1)
+
+	h.mu.Lock()
+	e, ok := h.jobs[id]
+	if ok {
+		e.watchers[notify] = struct{}{}
+	}
+	h.mu.Unlock()
+
+	if !ok {
+		return errors.New("unknown job " + id)
+	}
+
+	defer func() {
+		h.mu.Lock()
+		delete(e.watchers, notify)
+		h.mu.Unlock()
+	}()
+
+	for {
+		h.mu.Lock()
+		job := h.snapshot(e)
+		h.mu.Unlock()
+
+		if err := send(job); err != nil {
+			return err
+		}
+
+		if job.State != StateRunning {
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-notify:
+		}
+	}
+}
+
+// Close interrupts the running jobs and waits for them to record it
+func (h *jobHandler) Close() error {
+	h.stop()
+	h.wg.Wait()
+
+	h.store.flush()
+	return nil
+}
+
+func (h *jobHandler) flushLoop() {
+	defer h.wg.Done()
+
+	ticker := time.NewTicker(flushInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-h.ctx.Done():
+			return
+		case <-ticker.C:
+			h.store.flush()
+		}
+	}
+}
+
+func (h *jobHandler) pruneLoop() {
+	defer h.wg.Done()
+
+	ticker := time.NewTicker(pruneInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-h.ctx.Done():
+			return
+		case <-ticker.C:
+			h.prune()
+		}
+	}
+}
+
+// prune evicts the completed jobs past their retention, etcd expires them with their lease
+func (h *jobHandler) prune() {
+	var expired []string
+
+	h.mu.Lock()
+	for id, e := range h.jobs {
+		if e.job.State != StateRunning && time.Since(e.job.Updated) > retention {
+			delete(h.jobs, id)
+			expired = append(expired, id)
+		}
+	}
+	h.mu.Unlock()
+
+	for _, id := range expired {
+		h.store.remove(id)
+	}
+}
+
+func (h *jobHandler) finish(e *entry, ctx context.Context, err error) {
+	h.update(e, func(job *pkgTypes.Job) {
+		switch {
+		case err == nil:
+			job.State = StateSucceeded
+			job.Progress = 1
+		case h.ctx.Err() != nil:
+			job.State = StateInterrupted
+			job.Error = err.Error()
+		case ctx.Err() != nil:
+			job.State = StateCancelled
+			job.Error = err.Error()
+		default:
+			job.State = StateFailed
+			job.Error = err.Error()
+		}
+	})
+}
+
+func (h *jobHandler) update(e *entry, apply func(job *pkgTypes.Job)) {
+	h.mu.Lock()
+	state := e.job.State
+	apply(&e.job)
+	e.job.Updated = time.Now()
+	job := h.snapshot(e)
+
+	for notify := range e.watchers {
+		select {
+		case notify <- struct{}{}:
+		default:
+		}
+	}
+	h.mu.Unlock()
+
+	if job.State != state {
+		h.store.save(job)
+		return
+	}
+
+	h.store.mark(job)
+}
+
+// snapshot copies a job so it can be read without holding the lock
+func (h *jobHandler) snapshot(e *entry) *pkgTypes.Job {
+	job := e.job
+	job.Logs = append([]string(nil), e.job.Logs...)
+
+	return &job
+}
+
+func newId() (string, error) {
+	buf := make([]byte, 16)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(buf), nil
+}
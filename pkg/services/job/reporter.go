@@ -0,0 +1,33 @@
+package job
+
+import (
+	pkgTypes "github.com/alpha-omega-corp/github-svc/pkg/types"
+)
+
+type reporter struct {
+	handler *jobHandler
+	entry   *entry
+}
+
+func (r *reporter) Progress(progress float64, message string) {
+	r.handler.update(r.entry, func(job *pkgTypes.Job) {
+		job.Progress = progress
+		job.Message = message
+	})
+}
+
+func (r *reporter) Log(line string) {
+	r.handler.update(r.entry, func(job *pkgTypes.Job) {
+		job.Logs = append(job.Logs, line)
+		if len(job.Logs) > maxLogs {
+			job.Logs = job.Logs[len(job.Logs)-maxLogs:]
+		}
+	})
+}
+
+// Result replaces the job result, it is never modified in place so snapshots can share it
+func (r *reporter) Result(result pkgTypes.JobResult) {
+	r.handler.update(r.entry, func(job *pkgTypes.Job) {
+		job.Result = &result
+	})
+}
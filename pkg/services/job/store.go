@@ -0,0 +1,172 @@
+package job
+
+import (
+	"context"
+	"encoding/json"
+	pkgTypes "github.com/alpha-omega-corp/github-svc/pkg/types"
+	clientv3 "go.etcd.io/etcd/client/v3"
+	"log"
+	"strings"
+	"sync"
+	"time"
+)
+
+// logs are kept apart from the job so listing the jobs does not read them
+const (
+	keyPrefix    = "jobs/"
+	logKeyPrefix = "job-logs/"
+)
+
+type store struct {
+	client *clientv3.Client
+
+	// updates between state changes are only persisted by the periodic flush
+	pendingMu sync.Mutex
+	pending   map[string]*pkgTypes.Job
+
+	// writes run outside of the job lock, stale snapshots must not overwrite newer ones
+	mu    sync.Mutex
+	saved map[string]time.Time
+}
+
+func newStore(client *clientv3.Client) *store {
+	return &store{
+		client:  client,
+		pending: map[string]*pkgTypes.Job{},
+		saved:   map[string]time.Time{},
+	}
+}
+
+func (s *store) loadAll() ([]*pkgTypes.Job, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	res, err := s.client.Get(ctx, keyPrefix, clientv3.WithPrefix())
+	if err != nil {
+		return nil, err
+	}
+
+	logs, err := s.client.Get(ctx, logKeyPrefix, clientv3.WithPrefix())
+	if err != nil {
+		return nil, err
+	}
+
+	jobLogs := make(map[string][]string, len(logs.Kvs))
+	for _, kv := range logs.Kvs {
+		var lines []string
+		if err := json.Unmarshal(kv.Value, &lines); err != nil {
+			return nil, err
+		}
+
+		jobLogs[strings.TrimPrefix(string(kv.Key), logKeyPrefix)] = lines
+	}
+
+	jobs := make([]*pkgTypes.Job, 0, len(res.Kvs))
+	for _, kv := range res.Kvs {
+		job := new(pkgTypes.Job)
+		if err := json.Unmarshal(kv.Value, job); err != nil {
+			return nil, err
+		}
+
+		job.Logs = jobLogs[job.Id]
+		jobs = append(jobs, job)
+	}
+
+	return jobs, nil
+}
+
+// mark queues a job snapshot for the next flush
+func (s *store) mark(job *pkgTypes.Job) {
+	s.pendingMu.Lock()
+	defer s.pendingMu.Unlock()
+
+	if pending, ok := s.pending[job.Id]; ok && job.Updated.Before(pending.Updated) {
+		return
+	}
+
+	s.pending[job.Id] = job
+}
+
+// save persists a job right away, it supersedes the snapshot queued for it
+func (s *store) save(job *pkgTypes.Job) {
+	s.pendingMu.Lock()
+	if pending, ok := s.pending[job.Id]; ok && !pending.Updated.After(job.Updated) {
+		delete(s.pending, job.Id)
+	}
+	s.pendingMu.Unlock()
+
+	s.write(job)
+}
+
+// flush persists the queued snapshots
+func (s *store) flush() {
+	s.pendingMu.Lock()
+	pending := s.pending
+	s.pending = map[string]*pkgTypes.Job{}
+	s.pendingMu.Unlock()
+
+	for _, job := range pending {
+		s.write(job)
+	}
+}
+
+// write stores the job and its logs together, failures are logged since they must not fail the job itself
+func (s *store) write(job *pkgTypes.Job) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if job.Updated.Before(s.saved[job.Id]) {
+		return
+	}
+
+	state := *job
+	state.Logs = nil
+
+	value, err := json.Marshal(state)
+	if err != nil {
+		log.Printf("failed to encode job %s: %v", job.Id, err)
+		return
+	}
+
+	logs, err := json.Marshal(job.Logs)
+	if err != nil {
+		log.Printf("failed to encode the logs of job %s: %v", job.Id, err)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	// completed jobs are deleted once past their retention, by the prune loop or on startup
+	if _, err := s.client.Txn(ctx).Then(
+		clientv3.OpPut(keyPrefix+job.Id, string(value)),
+		clientv3.OpPut(logKeyPrefix+job.Id, string(logs)),
+	).Commit(); err != nil {
+		log.Printf("failed to persist job %s: %v", job.Id, err)
+		return
+	}
+
+	s.saved[job.Id] = job.Updated
+}
+
+func (s *store) remove(id string) {
+	s.pendingMu.Lock()
+	delete(s.pending, id)
+	s.pendingMu.Unlock()
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	if _, err := s.client.Txn(ctx).Then(
+		clientv3.OpDelete(keyPrefix+id),
+		clientv3.OpDelete(logKeyPrefix+id),
+	).Commit(); err != nil {
+		log.Printf("failed to remove job %s: %v", id, err)
+		return
+	}
+
+	delete(s.saved, id)
+}
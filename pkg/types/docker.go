@@ -0,0 +1,66 @@
+package types
+
+import (
+	"io"
+	"time"
+)
+
+type ContainerLogLine struct {
+	Stream    string
+	Timestamp string
+	Content   string
+}
+
+type ContainerStats struct {
+	Id            string
+	Name          string
+	Read          time.Time
+	CpuPercent    float64
+	MemoryUsage   uint64
+	MemoryLimit   uint64
+	MemoryPercent float64
+	NetworkRx     uint64
+	NetworkTx     uint64
+	BlockRead     uint64
+	BlockWrite    uint64
+	Pids          uint64
+}
+
+type TerminalSize struct {
+	Height uint
+	Width  uint
+}
+
+type ExecStream struct {
+	Stdin  io.Reader
+	Stdout io.Writer
+	Stderr io.Writer
+	Resize <-chan TerminalSize
+}
+
+type ContainerHealth struct {
+	State         string
+	Status        string
+	FailingStreak int
+	Output        string
+	ExitCode      int
+}
+
+type DockerEndpoint struct {
+	Name      string `mapstructure:"name"`
+	Host      string `mapstructure:"host"`
+	TLSCACert string `mapstructure:"tlsCaCert"`
+	TLSCert   string `mapstructure:"tlsCert"`
+	TLSKey    string `mapstructure:"tlsKey"`
+}
+
+type EngineStatus struct {
+	Endpoint    string
+	State       string
+	ApiVersion  string
+	OSType      string
+	Failures    int
+	LastError   string
+	LastPing    time.Time
+	ConnectedAt time.Time
+}
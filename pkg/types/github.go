@@ -1,11 +1,6 @@
 package types
 
-type CreateMakefileDto struct {
-	Registry string
-	OrgName  string
-	Name     string
-	Tag      string
-}
+import "time"
 
 type CreateDockerfileDto struct {
 	Name    string
@@ -50,3 +45,25 @@ type GitPackageVersion struct {
 		} `json:"container"`
 	} `json:"metadata"`
 }
+
+type SecretVersion struct {
+	Version        int64     `json:"version"`
+	Action         string    `json:"action"`
+	Content        []byte    `json:"content,omitempty"`
+	RolledBackFrom int64     `json:"rolledBackFrom,omitempty"`
+	Visibility     string    `json:"visibility,omitempty"`
+	Repositories   []string  `json:"repositories,omitempty"`
+	Created        time.Time `json:"created"`
+}
+
+type SecretScope struct {
+	Type        string
+	Store       string
+	Repository  string
+	Environment string
+}
+
+type SecretVisibility struct {
+	Visibility   string
+	Repositories []string
+}
@@ -0,0 +1,32 @@
+package types
+
+import "time"
+
+type Job struct {
+	Id       string     `json:"id"`
+	Type     string     `json:"type"`
+	State    string     `json:"state"`
+	Progress float64    `json:"progress"`
+	Message  string     `json:"message"`
+	Logs     []string   `json:"logs"`
+	Error    string     `json:"error"`
+	Result   *JobResult `json:"result,omitempty"`
+	Created  time.Time  `json:"created"`
+	Updated  time.Time  `json:"updated"`
+}
+
+// JobResult holds what the job produced, only the field matching its type is set
+type JobResult struct {
+	Container *ContainerResult `json:"container,omitempty"`
+	Push      *PushResult      `json:"push,omitempty"`
+}
+
+type ContainerResult struct {
+	ContainerId string           `json:"containerId"`
+	Health      *ContainerHealth `json:"health,omitempty"`
+}
+
+type PushResult struct {
+	Digest    string `json:"digest"`
+	VersionId int64  `json:"versionId,omitempty"`
+}
@@ -24,11 +24,52 @@ const _ = grpc.SupportPackageIsVersion7
 type DockerServiceClient interface {
 	DeleteContainer(ctx context.Context, in *DeleteContainerRequest, opts ...grpc.CallOption) (*DeleteContainerResponse, error)
 	GetContainers(ctx context.Context, in *GetContainersRequest, opts ...grpc.CallOption) (*GetContainersResponse, error)
+	InspectContainer(ctx context.Context, in *InspectContainerRequest, opts ...grpc.CallOption) (*InspectContainerResponse, error)
 	GetContainerLogs(ctx context.Context, in *GetContainerLogsRequest, opts ...grpc.CallOption) (*GetContainerLogsResponse, error)
+	StreamContainerLogs(ctx context.Context, in *StreamContainerLogsRequest, opts ...grpc.CallOption) (DockerService_StreamContainerLogsClient, error)
 	StartContainer(ctx context.Context, in *StartContainerRequest, opts ...grpc.CallOption) (*StartContainerResponse, error)
 	StopContainer(ctx context.Context, in *StopContainerRequest, opts ...grpc.CallOption) (*StopContainerResponse, error)
+	RestartContainer(ctx context.Context, in *RestartContainerRequest, opts ...grpc.CallOption) (*RestartContainerResponse, error)
+	KillContainer(ctx context.Context, in *KillContainerRequest, opts ...grpc.CallOption) (*KillContainerResponse, error)
+	PauseContainer(ctx context.Context, in *PauseContainerRequest, opts ...grpc.CallOption) (*PauseContainerResponse, error)
+	UnpauseContainer(ctx context.Context, in *UnpauseContainerRequest, opts ...grpc.CallOption) (*UnpauseContainerResponse, error)
+	RenameContainer(ctx context.Context, in *RenameContainerRequest, opts ...grpc.CallOption) (*RenameContainerResponse, error)
+	UpdateContainer(ctx context.Context, in *UpdateContainerRequest, opts ...grpc.CallOption) (*UpdateContainerResponse, error)
 	CreatePackageContainer(ctx context.Context, in *CreatePackageContainerRequest, opts ...grpc.CallOption) (*CreatePackageContainerResponse, error)
 	GetPackageVersionContainers(ctx context.Context, in *GetPackageVersionContainersRequest, opts ...grpc.CallOption) (*GetPackageVersionContainersResponse, error)
+	GetImages(ctx context.Context, in *GetImagesRequest, opts ...grpc.CallOption) (*GetImagesResponse, error)
+	InspectImage(ctx context.Context, in *InspectImageRequest, opts ...grpc.CallOption) (*InspectImageResponse, error)
+	PullImage(ctx context.Context, in *PullImageRequest, opts ...grpc.CallOption) (DockerService_PullImageClient, error)
+	PushImage(ctx context.Context, in *PushImageRequest, opts ...grpc.CallOption) (DockerService_PushImageClient, error)
+	RemoveImage(ctx context.Context, in *RemoveImageRequest, opts ...grpc.CallOption) (*RemoveImageResponse, error)
+	PruneImages(ctx context.Context, in *PruneImagesRequest, opts ...grpc.CallOption) (*PruneImagesResponse, error)
+	TagImage(ctx context.Context, in *TagImageRequest, opts ...grpc.CallOption) (*TagImageResponse, error)
+	WatchEvents(ctx context.Context, in *WatchEventsRequest, opts ...grpc.CallOption) (DockerService_WatchEventsClient, error)
+	GetContainerStats(ctx context.Context, in *GetContainerStatsRequest, opts ...grpc.CallOption) (*GetContainerStatsResponse, error)
+	WatchContainerStats(ctx context.Context, in *WatchContainerStatsRequest, opts ...grpc.CallOption) (DockerService_WatchContainerStatsClient, error)
+	ExecContainer(ctx context.Context, opts ...grpc.CallOption) (DockerService_ExecContainerClient, error)
+	CopyToContainer(ctx context.Context, opts ...grpc.CallOption) (DockerService_CopyToContainerClient, error)
+	CopyFromContainer(ctx context.Context, in *CopyFromContainerRequest, opts ...grpc.CallOption) (DockerService_CopyFromContainerClient, error)
+	StatContainerPath(ctx context.Context, in *StatContainerPathRequest, opts ...grpc.CallOption) (*StatContainerPathResponse, error)
+	CommitContainer(ctx context.Context, in *CommitContainerRequest, opts ...grpc.CallOption) (*CommitContainerResponse, error)
+	ListEndpoints(ctx context.Context, in *ListEndpointsRequest, opts ...grpc.CallOption) (*ListEndpointsResponse, error)
+	GetEngineStatus(ctx context.Context, in *GetEngineStatusRequest, opts ...grpc.CallOption) (*GetEngineStatusResponse, error)
+	BuildPackage(ctx context.Context, in *BuildPackageRequest, opts ...grpc.CallOption) (DockerService_BuildPackageClient, error)
+	GetManagedContainers(ctx context.Context, in *GetManagedContainersRequest, opts ...grpc.CallOption) (*GetManagedContainersResponse, error)
+	ReconcileContainers(ctx context.Context, in *ReconcileContainersRequest, opts ...grpc.CallOption) (*ReconcileContainersResponse, error)
+	CreateNetwork(ctx context.Context, in *CreateNetworkRequest, opts ...grpc.CallOption) (*CreateNetworkResponse, error)
+	GetNetworks(ctx context.Context, in *GetNetworksRequest, opts ...grpc.CallOption) (*GetNetworksResponse, error)
+	InspectNetwork(ctx context.Context, in *InspectNetworkRequest, opts ...grpc.CallOption) (*InspectNetworkResponse, error)
+	DeleteNetwork(ctx context.Context, in *DeleteNetworkRequest, opts ...grpc.CallOption) (*DeleteNetworkResponse, error)
+	ConnectNetwork(ctx context.Context, in *ConnectNetworkRequest, opts ...grpc.CallOption) (*ConnectNetworkResponse, error)
+	DisconnectNetwork(ctx context.Context, in *DisconnectNetworkRequest, opts ...grpc.CallOption) (*DisconnectNetworkResponse, error)
+	CreateVolume(ctx context.Context, in *CreateVolumeRequest, opts ...grpc.CallOption) (*CreateVolumeResponse, error)
+	GetVolumes(ctx context.Context, in *GetVolumesRequest, opts ...grpc.CallOption) (*GetVolumesResponse, error)
+	InspectVolume(ctx context.Context, in *InspectVolumeRequest, opts ...grpc.CallOption) (*InspectVolumeResponse, error)
+	DeleteVolume(ctx context.Context, in *DeleteVolumeRequest, opts ...grpc.CallOption) (*DeleteVolumeResponse, error)
+	DeployStack(ctx context.Context, in *DeployStackRequest, opts ...grpc.CallOption) (*DeployStackResponse, error)
+	GetStack(ctx context.Context, in *GetStackRequest, opts ...grpc.CallOption) (*GetStackResponse, error)
+	RemoveStack(ctx context.Context, in *RemoveStackRequest, opts ...grpc.CallOption) (*RemoveStackResponse, error)
 }
 
 type dockerServiceClient struct {
@@ -57,225 +98,1741 @@ func (c *dockerServiceClient) GetContainers(ctx context.Context, in *GetContaine
 	return out, nil
 }
 
+func (c *dockerServiceClient) InspectContainer(ctx context.Context, in *InspectContainerRequest, opts ...grpc.CallOption) (*InspectContainerResponse, error) {
+	out := new(InspectContainerResponse)
+	err := c.cc.Invoke(ctx, "/alphomega.docker.DockerService/InspectContainer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *dockerServiceClient) GetContainerLogs(ctx context.Context, in *GetContainerLogsRequest, opts ...grpc.CallOption) (*GetContainerLogsResponse, error) {
 	out := new(GetContainerLogsResponse)
 	err := c.cc.Invoke(ctx, "/alphomega.docker.DockerService/GetContainerLogs", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return out, nil
+	return out, nil
+}
+
+func (c *dockerServiceClient) StreamContainerLogs(ctx context.Context, in *StreamContainerLogsRequest, opts ...grpc.CallOption) (DockerService_StreamContainerLogsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &DockerService_ServiceDesc.Streams[0], "/alphomega.docker.DockerService/StreamContainerLogs", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &dockerServiceStreamContainerLogsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type DockerService_StreamContainerLogsClient interface {
+	Recv() (*StreamContainerLogsResponse, error)
+	grpc.ClientStream
+}
+
+type dockerServiceStreamContainerLogsClient struct {
+	grpc.ClientStream
+}
+
+func (x *dockerServiceStreamContainerLogsClient) Recv() (*StreamContainerLogsResponse, error) {
+	m := new(StreamContainerLogsResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *dockerServiceClient) StartContainer(ctx context.Context, in *StartContainerRequest, opts ...grpc.CallOption) (*StartContainerResponse, error) {
+	out := new(StartContainerResponse)
+	err := c.cc.Invoke(ctx, "/alphomega.docker.DockerService/StartContainer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dockerServiceClient) StopContainer(ctx context.Context, in *StopContainerRequest, opts ...grpc.CallOption) (*StopContainerResponse, error) {
+	out := new(StopContainerResponse)
+	err := c.cc.Invoke(ctx, "/alphomega.docker.DockerService/StopContainer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dockerServiceClient) RestartContainer(ctx context.Context, in *RestartContainerRequest, opts ...grpc.CallOption) (*RestartContainerResponse, error) {
+	out := new(RestartContainerResponse)
+	err := c.cc.Invoke(ctx, "/alphomega.docker.DockerService/RestartContainer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dockerServiceClient) KillContainer(ctx context.Context, in *KillContainerRequest, opts ...grpc.CallOption) (*KillContainerResponse, error) {
+	out := new(KillContainerResponse)
+	err := c.cc.Invoke(ctx, "/alphomega.docker.DockerService/KillContainer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dockerServiceClient) PauseContainer(ctx context.Context, in *PauseContainerRequest, opts ...grpc.CallOption) (*PauseContainerResponse, error) {
+	out := new(PauseContainerResponse)
+	err := c.cc.Invoke(ctx, "/alphomega.docker.DockerService/PauseContainer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dockerServiceClient) UnpauseContainer(ctx context.Context, in *UnpauseContainerRequest, opts ...grpc.CallOption) (*UnpauseContainerResponse, error) {
+	out := new(UnpauseContainerResponse)
+	err := c.cc.Invoke(ctx, "/alphomega.docker.DockerService/UnpauseContainer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dockerServiceClient) RenameContainer(ctx context.Context, in *RenameContainerRequest, opts ...grpc.CallOption) (*RenameContainerResponse, error) {
+	out := new(RenameContainerResponse)
+	err := c.cc.Invoke(ctx, "/alphomega.docker.DockerService/RenameContainer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dockerServiceClient) UpdateContainer(ctx context.Context, in *UpdateContainerRequest, opts ...grpc.CallOption) (*UpdateContainerResponse, error) {
+	out := new(UpdateContainerResponse)
+	err := c.cc.Invoke(ctx, "/alphomega.docker.DockerService/UpdateContainer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dockerServiceClient) CreatePackageContainer(ctx context.Context, in *CreatePackageContainerRequest, opts ...grpc.CallOption) (*CreatePackageContainerResponse, error) {
+	out := new(CreatePackageContainerResponse)
+	err := c.cc.Invoke(ctx, "/alphomega.docker.DockerService/CreatePackageContainer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dockerServiceClient) GetPackageVersionContainers(ctx context.Context, in *GetPackageVersionContainersRequest, opts ...grpc.CallOption) (*GetPackageVersionContainersResponse, error) {
+	out := new(GetPackageVersionContainersResponse)
+	err := c.cc.Invoke(ctx, "/alphomega.docker.DockerService/GetPackageVersionContainers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dockerServiceClient) GetImages(ctx context.Context, in *GetImagesRequest, opts ...grpc.CallOption) (*GetImagesResponse, error) {
+	out := new(GetImagesResponse)
+	err := c.cc.Invoke(ctx, "/alphomega.docker.DockerService/GetImages", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dockerServiceClient) InspectImage(ctx context.Context, in *InspectImageRequest, opts ...grpc.CallOption) (*InspectImageResponse, error) {
+	out := new(InspectImageResponse)
+	err := c.cc.Invoke(ctx, "/alphomega.docker.DockerService/InspectImage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dockerServiceClient) PullImage(ctx context.Context, in *PullImageRequest, opts ...grpc.CallOption) (DockerService_PullImageClient, error) {
+	stream, err := c.cc.NewStream(ctx, &DockerService_ServiceDesc.Streams[1], "/alphomega.docker.DockerService/PullImage", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &dockerServicePullImageClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type DockerService_PullImageClient interface {
+	Recv() (*PullImageResponse, error)
+	grpc.ClientStream
+}
+
+type dockerServicePullImageClient struct {
+	grpc.ClientStream
+}
+
+func (x *dockerServicePullImageClient) Recv() (*PullImageResponse, error) {
+	m := new(PullImageResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *dockerServiceClient) PushImage(ctx context.Context, in *PushImageRequest, opts ...grpc.CallOption) (DockerService_PushImageClient, error) {
+	stream, err := c.cc.NewStream(ctx, &DockerService_ServiceDesc.Streams[2], "/alphomega.docker.DockerService/PushImage", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &dockerServicePushImageClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type DockerService_PushImageClient interface {
+	Recv() (*PushImageResponse, error)
+	grpc.ClientStream
+}
+
+type dockerServicePushImageClient struct {
+	grpc.ClientStream
+}
+
+func (x *dockerServicePushImageClient) Recv() (*PushImageResponse, error) {
+	m := new(PushImageResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *dockerServiceClient) RemoveImage(ctx context.Context, in *RemoveImageRequest, opts ...grpc.CallOption) (*RemoveImageResponse, error) {
+	out := new(RemoveImageResponse)
+	err := c.cc.Invoke(ctx, "/alphomega.docker.DockerService/RemoveImage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dockerServiceClient) PruneImages(ctx context.Context, in *PruneImagesRequest, opts ...grpc.CallOption) (*PruneImagesResponse, error) {
+	out := new(PruneImagesResponse)
+	err := c.cc.Invoke(ctx, "/alphomega.docker.DockerService/PruneImages", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dockerServiceClient) TagImage(ctx context.Context, in *TagImageRequest, opts ...grpc.CallOption) (*TagImageResponse, error) {
+	out := new(TagImageResponse)
+	err := c.cc.Invoke(ctx, "/alphomega.docker.DockerService/TagImage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dockerServiceClient) WatchEvents(ctx context.Context, in *WatchEventsRequest, opts ...grpc.CallOption) (DockerService_WatchEventsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &DockerService_ServiceDesc.Streams[3], "/alphomega.docker.DockerService/WatchEvents", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &dockerServiceWatchEventsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type DockerService_WatchEventsClient interface {
+	Recv() (*WatchEventsResponse, error)
+	grpc.ClientStream
+}
+
+type dockerServiceWatchEventsClient struct {
+	grpc.ClientStream
+}
+
+func (x *dockerServiceWatchEventsClient) Recv() (*WatchEventsResponse, error) {
+	m := new(WatchEventsResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *dockerServiceClient) GetContainerStats(ctx context.Context, in *GetContainerStatsRequest, opts ...grpc.CallOption) (*GetContainerStatsResponse, error) {
+	out := new(GetContainerStatsResponse)
+	err := c.cc.Invoke(ctx, "/alphomega.docker.DockerService/GetContainerStats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dockerServiceClient) WatchContainerStats(ctx context.Context, in *WatchContainerStatsRequest, opts ...grpc.CallOption) (DockerService_WatchContainerStatsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &DockerService_ServiceDesc.Streams[4], "/alphomega.docker.DockerService/WatchContainerStats", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &dockerServiceWatchContainerStatsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type DockerService_WatchContainerStatsClient interface {
+	Recv() (*WatchContainerStatsResponse, error)
+	grpc.ClientStream
+}
+
+type dockerServiceWatchContainerStatsClient struct {
+	grpc.ClientStream
+}
+
+func (x *dockerServiceWatchContainerStatsClient) Recv() (*WatchContainerStatsResponse, error) {
+	m := new(WatchContainerStatsResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *dockerServiceClient) ExecContainer(ctx context.Context, opts ...grpc.CallOption) (DockerService_ExecContainerClient, error) {
+	stream, err := c.cc.NewStream(ctx, &DockerService_ServiceDesc.Streams[5], "/alphomega.docker.DockerService/ExecContainer", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &dockerServiceExecContainerClient{stream}
+	return x, nil
+}
+
+type DockerService_ExecContainerClient interface {
+	Send(*ExecContainerRequest) error
+	Recv() (*ExecContainerResponse, error)
+	grpc.ClientStream
+}
+
+type dockerServiceExecContainerClient struct {
+	grpc.ClientStream
+}
+
+func (x *dockerServiceExecContainerClient) Send(m *ExecContainerRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *dockerServiceExecContainerClient) Recv() (*ExecContainerResponse, error) {
+	m := new(ExecContainerResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *dockerServiceClient) CopyToContainer(ctx context.Context, opts ...grpc.CallOption) (DockerService_CopyToContainerClient, error) {
+	stream, err := c.cc.NewStream(ctx, &DockerService_ServiceDesc.Streams[6], "/alphomega.docker.DockerService/CopyToContainer", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &dockerServiceCopyToContainerClient{stream}
+	return x, nil
+}
+
+type DockerService_CopyToContainerClient interface {
+	Send(*CopyToContainerRequest) error
+	CloseAndRecv() (*CopyToContainerResponse, error)
+	grpc.ClientStream
+}
+
+type dockerServiceCopyToContainerClient struct {
+	grpc.ClientStream
+}
+
+func (x *dockerServiceCopyToContainerClient) Send(m *CopyToContainerRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *dockerServiceCopyToContainerClient) CloseAndRecv() (*CopyToContainerResponse, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(CopyToContainerResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *dockerServiceClient) CopyFromContainer(ctx context.Context, in *CopyFromContainerRequest, opts ...grpc.CallOption) (DockerService_CopyFromContainerClient, error) {
+	stream, err := c.cc.NewStream(ctx, &DockerService_ServiceDesc.Streams[7], "/alphomega.docker.DockerService/CopyFromContainer", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &dockerServiceCopyFromContainerClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type DockerService_CopyFromContainerClient interface {
+	Recv() (*CopyFromContainerResponse, error)
+	grpc.ClientStream
+}
+
+type dockerServiceCopyFromContainerClient struct {
+	grpc.ClientStream
+}
+
+func (x *dockerServiceCopyFromContainerClient) Recv() (*CopyFromContainerResponse, error) {
+	m := new(CopyFromContainerResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *dockerServiceClient) StatContainerPath(ctx context.Context, in *StatContainerPathRequest, opts ...grpc.CallOption) (*StatContainerPathResponse, error) {
+	out := new(StatContainerPathResponse)
+	err := c.cc.Invoke(ctx, "/alphomega.docker.DockerService/StatContainerPath", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dockerServiceClient) CommitContainer(ctx context.Context, in *CommitContainerRequest, opts ...grpc.CallOption) (*CommitContainerResponse, error) {
+	out := new(CommitContainerResponse)
+	err := c.cc.Invoke(ctx, "/alphomega.docker.DockerService/CommitContainer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dockerServiceClient) ListEndpoints(ctx context.Context, in *ListEndpointsRequest, opts ...grpc.CallOption) (*ListEndpointsResponse, error) {
+	out := new(ListEndpointsResponse)
+	err := c.cc.Invoke(ctx, "/alphomega.docker.DockerService/ListEndpoints", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dockerServiceClient) GetEngineStatus(ctx context.Context, in *GetEngineStatusRequest, opts ...grpc.CallOption) (*GetEngineStatusResponse, error) {
+	out := new(GetEngineStatusResponse)
+	err := c.cc.Invoke(ctx, "/alphomega.docker.DockerService/GetEngineStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dockerServiceClient) BuildPackage(ctx context.Context, in *BuildPackageRequest, opts ...grpc.CallOption) (DockerService_BuildPackageClient, error) {
+	stream, err := c.cc.NewStream(ctx, &DockerService_ServiceDesc.Streams[8], "/alphomega.docker.DockerService/BuildPackage", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &dockerServiceBuildPackageClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type DockerService_BuildPackageClient interface {
+	Recv() (*BuildPackageResponse, error)
+	grpc.ClientStream
+}
+
+type dockerServiceBuildPackageClient struct {
+	grpc.ClientStream
+}
+
+func (x *dockerServiceBuildPackageClient) Recv() (*BuildPackageResponse, error) {
+	m := new(BuildPackageResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *dockerServiceClient) GetManagedContainers(ctx context.Context, in *GetManagedContainersRequest, opts ...grpc.CallOption) (*GetManagedContainersResponse, error) {
+	out := new(GetManagedContainersResponse)
+	err := c.cc.Invoke(ctx, "/alphomega.docker.DockerService/GetManagedContainers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dockerServiceClient) ReconcileContainers(ctx context.Context, in *ReconcileContainersRequest, opts ...grpc.CallOption) (*ReconcileContainersResponse, error) {
+	out := new(ReconcileContainersResponse)
+	err := c.cc.Invoke(ctx, "/alphomega.docker.DockerService/ReconcileContainers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dockerServiceClient) CreateNetwork(ctx context.Context, in *CreateNetworkRequest, opts ...grpc.CallOption) (*CreateNetworkResponse, error) {
+	out := new(CreateNetworkResponse)
+	err := c.cc.Invoke(ctx, "/alphomega.docker.DockerService/CreateNetwork", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dockerServiceClient) GetNetworks(ctx context.Context, in *GetNetworksRequest, opts ...grpc.CallOption) (*GetNetworksResponse, error) {
+	out := new(GetNetworksResponse)
+	err := c.cc.Invoke(ctx, "/alphomega.docker.DockerService/GetNetworks", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dockerServiceClient) InspectNetwork(ctx context.Context, in *InspectNetworkRequest, opts ...grpc.CallOption) (*InspectNetworkResponse, error) {
+	out := new(InspectNetworkResponse)
+	err := c.cc.Invoke(ctx, "/alphomega.docker.DockerService/InspectNetwork", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dockerServiceClient) DeleteNetwork(ctx context.Context, in *DeleteNetworkRequest, opts ...grpc.CallOption) (*DeleteNetworkResponse, error) {
+	out := new(DeleteNetworkResponse)
+	err := c.cc.Invoke(ctx, "/alphomega.docker.DockerService/DeleteNetwork", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dockerServiceClient) ConnectNetwork(ctx context.Context, in *ConnectNetworkRequest, opts ...grpc.CallOption) (*ConnectNetworkResponse, error) {
+	out := new(ConnectNetworkResponse)
+	err := c.cc.Invoke(ctx, "/alphomega.docker.DockerService/ConnectNetwork", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dockerServiceClient) DisconnectNetwork(ctx context.Context, in *DisconnectNetworkRequest, opts ...grpc.CallOption) (*DisconnectNetworkResponse, error) {
+	out := new(DisconnectNetworkResponse)
+	err := c.cc.Invoke(ctx, "/alphomega.docker.DockerService/DisconnectNetwork", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dockerServiceClient) CreateVolume(ctx context.Context, in *CreateVolumeRequest, opts ...grpc.CallOption) (*CreateVolumeResponse, error) {
+	out := new(CreateVolumeResponse)
+	err := c.cc.Invoke(ctx, "/alphomega.docker.DockerService/CreateVolume", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dockerServiceClient) GetVolumes(ctx context.Context, in *GetVolumesRequest, opts ...grpc.CallOption) (*GetVolumesResponse, error) {
+	out := new(GetVolumesResponse)
+	err := c.cc.Invoke(ctx, "/alphomega.docker.DockerService/GetVolumes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dockerServiceClient) InspectVolume(ctx context.Context, in *InspectVolumeRequest, opts ...grpc.CallOption) (*InspectVolumeResponse, error) {
+	out := new(InspectVolumeResponse)
+	err := c.cc.Invoke(ctx, "/alphomega.docker.DockerService/InspectVolume", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dockerServiceClient) DeleteVolume(ctx context.Context, in *DeleteVolumeRequest, opts ...grpc.CallOption) (*DeleteVolumeResponse, error) {
+	out := new(DeleteVolumeResponse)
+	err := c.cc.Invoke(ctx, "/alphomega.docker.DockerService/DeleteVolume", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dockerServiceClient) DeployStack(ctx context.Context, in *DeployStackRequest, opts ...grpc.CallOption) (*DeployStackResponse, error) {
+	out := new(DeployStackResponse)
+	err := c.cc.Invoke(ctx, "/alphomega.docker.DockerService/DeployStack", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dockerServiceClient) GetStack(ctx context.Context, in *GetStackRequest, opts ...grpc.CallOption) (*GetStackResponse, error) {
+	out := new(GetStackResponse)
+	err := c.cc.Invoke(ctx, "/alphomega.docker.DockerService/GetStack", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dockerServiceClient) RemoveStack(ctx context.Context, in *RemoveStackRequest, opts ...grpc.CallOption) (*RemoveStackResponse, error) {
+	out := new(RemoveStackResponse)
+	err := c.cc.Invoke(ctx, "/alphomega.docker.DockerService/RemoveStack", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// DockerServiceServer is the server API for DockerService service.
+// All implementations must embed UnimplementedDockerServiceServer
+// for forward compatibility
+type DockerServiceServer interface {
+	DeleteContainer(context.Context, *DeleteContainerRequest) (*DeleteContainerResponse, error)
+	GetContainers(context.Context, *GetContainersRequest) (*GetContainersResponse, error)
+	InspectContainer(context.Context, *InspectContainerRequest) (*InspectContainerResponse, error)
+	GetContainerLogs(context.Context, *GetContainerLogsRequest) (*GetContainerLogsResponse, error)
+	StreamContainerLogs(*StreamContainerLogsRequest, DockerService_StreamContainerLogsServer) error
+	StartContainer(context.Context, *StartContainerRequest) (*StartContainerResponse, error)
+	StopContainer(context.Context, *StopContainerRequest) (*StopContainerResponse, error)
+	RestartContainer(context.Context, *RestartContainerRequest) (*RestartContainerResponse, error)
+	KillContainer(context.Context, *KillContainerRequest) (*KillContainerResponse, error)
+	PauseContainer(context.Context, *PauseContainerRequest) (*PauseContainerResponse, error)
+	UnpauseContainer(context.Context, *UnpauseContainerRequest) (*UnpauseContainerResponse, error)
+	RenameContainer(context.Context, *RenameContainerRequest) (*RenameContainerResponse, error)
+	UpdateContainer(context.Context, *UpdateContainerRequest) (*UpdateContainerResponse, error)
+	CreatePackageContainer(context.Context, *CreatePackageContainerRequest) (*CreatePackageContainerResponse, error)
+	GetPackageVersionContainers(context.Context, *GetPackageVersionContainersRequest) (*GetPackageVersionContainersResponse, error)
+	GetImages(context.Context, *GetImagesRequest) (*GetImagesResponse, error)
+	InspectImage(context.Context, *InspectImageRequest) (*InspectImageResponse, error)
+	PullImage(*PullImageRequest, DockerService_PullImageServer) error
+	PushImage(*PushImageRequest, DockerService_PushImageServer) error
+	RemoveImage(context.Context, *RemoveImageRequest) (*RemoveImageResponse, error)
+	PruneImages(context.Context, *PruneImagesRequest) (*PruneImagesResponse, error)
+	TagImage(context.Context, *TagImageRequest) (*TagImageResponse, error)
+	WatchEvents(*WatchEventsRequest, DockerService_WatchEventsServer) error
+	GetContainerStats(context.Context, *GetContainerStatsRequest) (*GetContainerStatsResponse, error)
+	WatchContainerStats(*WatchContainerStatsRequest, DockerService_WatchContainerStatsServer) error
+	ExecContainer(DockerService_ExecContainerServer) error
+	CopyToContainer(DockerService_CopyToContainerServer) error
+	CopyFromContainer(*CopyFromContainerRequest, DockerService_CopyFromContainerServer) error
+	StatContainerPath(context.Context, *StatContainerPathRequest) (*StatContainerPathResponse, error)
+	CommitContainer(context.Context, *CommitContainerRequest) (*CommitContainerResponse, error)
+	ListEndpoints(context.Context, *ListEndpointsRequest) (*ListEndpointsResponse, error)
+	GetEngineStatus(context.Context, *GetEngineStatusRequest) (*GetEngineStatusResponse, error)
+	BuildPackage(*BuildPackageRequest, DockerService_BuildPackageServer) error
+	GetManagedContainers(context.Context, *GetManagedContainersRequest) (*GetManagedContainersResponse, error)
+	ReconcileContainers(context.Context, *ReconcileContainersRequest) (*ReconcileContainersResponse, error)
+	CreateNetwork(context.Context, *CreateNetworkRequest) (*CreateNetworkResponse, error)
+	GetNetworks(context.Context, *GetNetworksRequest) (*GetNetworksResponse, error)
+	InspectNetwork(context.Context, *InspectNetworkRequest) (*InspectNetworkResponse, error)
+	DeleteNetwork(context.Context, *DeleteNetworkRequest) (*DeleteNetworkResponse, error)
+	ConnectNetwork(context.Context, *ConnectNetworkRequest) (*ConnectNetworkResponse, error)
+	DisconnectNetwork(context.Context, *DisconnectNetworkRequest) (*DisconnectNetworkResponse, error)
+	CreateVolume(context.Context, *CreateVolumeRequest) (*CreateVolumeResponse, error)
+	GetVolumes(context.Context, *GetVolumesRequest) (*GetVolumesResponse, error)
+	InspectVolume(context.Context, *InspectVolumeRequest) (*InspectVolumeResponse, error)
+	DeleteVolume(context.Context, *DeleteVolumeRequest) (*DeleteVolumeResponse, error)
+	DeployStack(context.Context, *DeployStackRequest) (*DeployStackResponse, error)
+	GetStack(context.Context, *GetStackRequest) (*GetStackResponse, error)
+	RemoveStack(context.Context, *RemoveStackRequest) (*RemoveStackResponse, error)
+	mustEmbedUnimplementedDockerServiceServer()
+}
+
+// UnimplementedDockerServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedDockerServiceServer struct {
+}
+
+func (UnimplementedDockerServiceServer) DeleteContainer(context.Context, *DeleteContainerRequest) (*DeleteContainerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteContainer not implemented")
+}
+func (UnimplementedDockerServiceServer) GetContainers(context.Context, *GetContainersRequest) (*GetContainersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetContainers not implemented")
+}
+func (UnimplementedDockerServiceServer) InspectContainer(context.Context, *InspectContainerRequest) (*InspectContainerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InspectContainer not implemented")
+}
+func (UnimplementedDockerServiceServer) GetContainerLogs(context.Context, *GetContainerLogsRequest) (*GetContainerLogsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetContainerLogs not implemented")
+}
+func (UnimplementedDockerServiceServer) StreamContainerLogs(*StreamContainerLogsRequest, DockerService_StreamContainerLogsServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamContainerLogs not implemented")
+}
+func (UnimplementedDockerServiceServer) StartContainer(context.Context, *StartContainerRequest) (*StartContainerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StartContainer not implemented")
+}
+func (UnimplementedDockerServiceServer) StopContainer(context.Context, *StopContainerRequest) (*StopContainerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StopContainer not implemented")
+}
+func (UnimplementedDockerServiceServer) RestartContainer(context.Context, *RestartContainerRequest) (*RestartContainerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestartContainer not implemented")
+}
+func (UnimplementedDockerServiceServer) KillContainer(context.Context, *KillContainerRequest) (*KillContainerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method KillContainer not implemented")
+}
+func (UnimplementedDockerServiceServer) PauseContainer(context.Context, *PauseContainerRequest) (*PauseContainerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PauseContainer not implemented")
+}
+func (UnimplementedDockerServiceServer) UnpauseContainer(context.Context, *UnpauseContainerRequest) (*UnpauseContainerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnpauseContainer not implemented")
+}
+func (UnimplementedDockerServiceServer) RenameContainer(context.Context, *RenameContainerRequest) (*RenameContainerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RenameContainer not implemented")
+}
+func (UnimplementedDockerServiceServer) UpdateContainer(context.Context, *UpdateContainerRequest) (*UpdateContainerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateContainer not implemented")
+}
+func (UnimplementedDockerServiceServer) CreatePackageContainer(context.Context, *CreatePackageContainerRequest) (*CreatePackageContainerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreatePackageContainer not implemented")
+}
+func (UnimplementedDockerServiceServer) GetPackageVersionContainers(context.Context, *GetPackageVersionContainersRequest) (*GetPackageVersionContainersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPackageVersionContainers not implemented")
+}
+func (UnimplementedDockerServiceServer) GetImages(context.Context, *GetImagesRequest) (*GetImagesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetImages not implemented")
+}
+func (UnimplementedDockerServiceServer) InspectImage(context.Context, *InspectImageRequest) (*InspectImageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InspectImage not implemented")
+}
+func (UnimplementedDockerServiceServer) PullImage(*PullImageRequest, DockerService_PullImageServer) error {
+	return status.Errorf(codes.Unimplemented, "method PullImage not implemented")
+}
+func (UnimplementedDockerServiceServer) PushImage(*PushImageRequest, DockerService_PushImageServer) error {
+	return status.Errorf(codes.Unimplemented, "method PushImage not implemented")
+}
+func (UnimplementedDockerServiceServer) RemoveImage(context.Context, *RemoveImageRequest) (*RemoveImageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveImage not implemented")
+}
+func (UnimplementedDockerServiceServer) PruneImages(context.Context, *PruneImagesRequest) (*PruneImagesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PruneImages not implemented")
+}
+func (UnimplementedDockerServiceServer) TagImage(context.Context, *TagImageRequest) (*TagImageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TagImage not implemented")
+}
+func (UnimplementedDockerServiceServer) WatchEvents(*WatchEventsRequest, DockerService_WatchEventsServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchEvents not implemented")
+}
+func (UnimplementedDockerServiceServer) GetContainerStats(context.Context, *GetContainerStatsRequest) (*GetContainerStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetContainerStats not implemented")
+}
+func (UnimplementedDockerServiceServer) WatchContainerStats(*WatchContainerStatsRequest, DockerService_WatchContainerStatsServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchContainerStats not implemented")
+}
+func (UnimplementedDockerServiceServer) ExecContainer(DockerService_ExecContainerServer) error {
+	return status.Errorf(codes.Unimplemented, "method ExecContainer not implemented")
+}
+func (UnimplementedDockerServiceServer) CopyToContainer(DockerService_CopyToContainerServer) error {
+	return status.Errorf(codes.Unimplemented, "method CopyToContainer not implemented")
+}
+func (UnimplementedDockerServiceServer) CopyFromContainer(*CopyFromContainerRequest, DockerService_CopyFromContainerServer) error {
+	return status.Errorf(codes.Unimplemented, "method CopyFromContainer not implemented")
+}
+func (UnimplementedDockerServiceServer) StatContainerPath(context.Context, *StatContainerPathRequest) (*StatContainerPathResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StatContainerPath not implemented")
+}
+func (UnimplementedDockerServiceServer) CommitContainer(context.Context, *CommitContainerRequest) (*CommitContainerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CommitContainer not implemented")
+}
+func (UnimplementedDockerServiceServer) ListEndpoints(context.Context, *ListEndpointsRequest) (*ListEndpointsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListEndpoints not implemented")
+}
+func (UnimplementedDockerServiceServer) GetEngineStatus(context.Context, *GetEngineStatusRequest) (*GetEngineStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetEngineStatus not implemented")
+}
+func (UnimplementedDockerServiceServer) BuildPackage(*BuildPackageRequest, DockerService_BuildPackageServer) error {
+	return status.Errorf(codes.Unimplemented, "method BuildPackage not implemented")
+}
+func (UnimplementedDockerServiceServer) GetManagedContainers(context.Context, *GetManagedContainersRequest) (*GetManagedContainersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetManagedContainers not implemented")
+}
+func (UnimplementedDockerServiceServer) ReconcileContainers(context.Context, *ReconcileContainersRequest) (*ReconcileContainersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReconcileContainers not implemented")
+}
+func (UnimplementedDockerServiceServer) CreateNetwork(context.Context, *CreateNetworkRequest) (*CreateNetworkResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateNetwork not implemented")
+}
+func (UnimplementedDockerServiceServer) GetNetworks(context.Context, *GetNetworksRequest) (*GetNetworksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetNetworks not implemented")
+}
+func (UnimplementedDockerServiceServer) InspectNetwork(context.Context, *InspectNetworkRequest) (*InspectNetworkResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InspectNetwork not implemented")
+}
+func (UnimplementedDockerServiceServer) DeleteNetwork(context.Context, *DeleteNetworkRequest) (*DeleteNetworkResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteNetwork not implemented")
+}
+func (UnimplementedDockerServiceServer) ConnectNetwork(context.Context, *ConnectNetworkRequest) (*ConnectNetworkResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConnectNetwork not implemented")
+}
+func (UnimplementedDockerServiceServer) DisconnectNetwork(context.Context, *DisconnectNetworkRequest) (*DisconnectNetworkResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DisconnectNetwork not implemented")
+}
+func (UnimplementedDockerServiceServer) CreateVolume(context.Context, *CreateVolumeRequest) (*CreateVolumeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateVolume not implemented")
+}
+func (UnimplementedDockerServiceServer) GetVolumes(context.Context, *GetVolumesRequest) (*GetVolumesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetVolumes not implemented")
+}
+func (UnimplementedDockerServiceServer) InspectVolume(context.Context, *InspectVolumeRequest) (*InspectVolumeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InspectVolume not implemented")
+}
+func (UnimplementedDockerServiceServer) DeleteVolume(context.Context, *DeleteVolumeRequest) (*DeleteVolumeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteVolume not implemented")
+}
+func (UnimplementedDockerServiceServer) DeployStack(context.Context, *DeployStackRequest) (*DeployStackResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeployStack not implemented")
+}
+func (UnimplementedDockerServiceServer) GetStack(context.Context, *GetStackRequest) (*GetStackResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetStack not implemented")
+}
+func (UnimplementedDockerServiceServer) RemoveStack(context.Context, *RemoveStackRequest) (*RemoveStackResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveStack not implemented")
+}
+func (UnimplementedDockerServiceServer) mustEmbedUnimplementedDockerServiceServer() {}
+
+// UnsafeDockerServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to DockerServiceServer will
+// result in compilation errors.
+type UnsafeDockerServiceServer interface {
+	mustEmbedUnimplementedDockerServiceServer()
+}
+
+func RegisterDockerServiceServer(s grpc.ServiceRegistrar, srv DockerServiceServer) {
+	s.RegisterService(&DockerService_ServiceDesc, srv)
+}
+
+func _DockerService_DeleteContainer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteContainerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DockerServiceServer).DeleteContainer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/alphomega.docker.DockerService/DeleteContainer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DockerServiceServer).DeleteContainer(ctx, req.(*DeleteContainerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DockerService_GetContainers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetContainersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DockerServiceServer).GetContainers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/alphomega.docker.DockerService/GetContainers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DockerServiceServer).GetContainers(ctx, req.(*GetContainersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DockerService_InspectContainer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InspectContainerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DockerServiceServer).InspectContainer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/alphomega.docker.DockerService/InspectContainer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DockerServiceServer).InspectContainer(ctx, req.(*InspectContainerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DockerService_GetContainerLogs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetContainerLogsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DockerServiceServer).GetContainerLogs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/alphomega.docker.DockerService/GetContainerLogs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DockerServiceServer).GetContainerLogs(ctx, req.(*GetContainerLogsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DockerService_StreamContainerLogs_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamContainerLogsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(DockerServiceServer).StreamContainerLogs(m, &dockerServiceStreamContainerLogsServer{stream})
+}
+
+type DockerService_StreamContainerLogsServer interface {
+	Send(*StreamContainerLogsResponse) error
+	grpc.ServerStream
+}
+
+type dockerServiceStreamContainerLogsServer struct {
+	grpc.ServerStream
+}
+
+func (x *dockerServiceStreamContainerLogsServer) Send(m *StreamContainerLogsResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _DockerService_StartContainer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StartContainerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DockerServiceServer).StartContainer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/alphomega.docker.DockerService/StartContainer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DockerServiceServer).StartContainer(ctx, req.(*StartContainerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DockerService_StopContainer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StopContainerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DockerServiceServer).StopContainer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/alphomega.docker.DockerService/StopContainer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DockerServiceServer).StopContainer(ctx, req.(*StopContainerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DockerService_RestartContainer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestartContainerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DockerServiceServer).RestartContainer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/alphomega.docker.DockerService/RestartContainer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DockerServiceServer).RestartContainer(ctx, req.(*RestartContainerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DockerService_KillContainer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(KillContainerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DockerServiceServer).KillContainer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/alphomega.docker.DockerService/KillContainer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DockerServiceServer).KillContainer(ctx, req.(*KillContainerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DockerService_PauseContainer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PauseContainerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DockerServiceServer).PauseContainer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/alphomega.docker.DockerService/PauseContainer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DockerServiceServer).PauseContainer(ctx, req.(*PauseContainerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DockerService_UnpauseContainer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnpauseContainerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DockerServiceServer).UnpauseContainer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/alphomega.docker.DockerService/UnpauseContainer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DockerServiceServer).UnpauseContainer(ctx, req.(*UnpauseContainerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DockerService_RenameContainer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RenameContainerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DockerServiceServer).RenameContainer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/alphomega.docker.DockerService/RenameContainer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DockerServiceServer).RenameContainer(ctx, req.(*RenameContainerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DockerService_UpdateContainer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateContainerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DockerServiceServer).UpdateContainer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/alphomega.docker.DockerService/UpdateContainer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DockerServiceServer).UpdateContainer(ctx, req.(*UpdateContainerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DockerService_CreatePackageContainer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreatePackageContainerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DockerServiceServer).CreatePackageContainer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/alphomega.docker.DockerService/CreatePackageContainer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DockerServiceServer).CreatePackageContainer(ctx, req.(*CreatePackageContainerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DockerService_GetPackageVersionContainers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPackageVersionContainersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DockerServiceServer).GetPackageVersionContainers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/alphomega.docker.DockerService/GetPackageVersionContainers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DockerServiceServer).GetPackageVersionContainers(ctx, req.(*GetPackageVersionContainersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DockerService_GetImages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetImagesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DockerServiceServer).GetImages(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/alphomega.docker.DockerService/GetImages",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DockerServiceServer).GetImages(ctx, req.(*GetImagesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DockerService_InspectImage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InspectImageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DockerServiceServer).InspectImage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/alphomega.docker.DockerService/InspectImage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DockerServiceServer).InspectImage(ctx, req.(*InspectImageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DockerService_PullImage_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(PullImageRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(DockerServiceServer).PullImage(m, &dockerServicePullImageServer{stream})
+}
+
+type DockerService_PullImageServer interface {
+	Send(*PullImageResponse) error
+	grpc.ServerStream
+}
+
+type dockerServicePullImageServer struct {
+	grpc.ServerStream
+}
+
+func (x *dockerServicePullImageServer) Send(m *PullImageResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _DockerService_PushImage_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(PushImageRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(DockerServiceServer).PushImage(m, &dockerServicePushImageServer{stream})
+}
+
+type DockerService_PushImageServer interface {
+	Send(*PushImageResponse) error
+	grpc.ServerStream
+}
+
+type dockerServicePushImageServer struct {
+	grpc.ServerStream
+}
+
+func (x *dockerServicePushImageServer) Send(m *PushImageResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _DockerService_RemoveImage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveImageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DockerServiceServer).RemoveImage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/alphomega.docker.DockerService/RemoveImage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DockerServiceServer).RemoveImage(ctx, req.(*RemoveImageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DockerService_PruneImages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PruneImagesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DockerServiceServer).PruneImages(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/alphomega.docker.DockerService/PruneImages",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DockerServiceServer).PruneImages(ctx, req.(*PruneImagesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DockerService_TagImage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TagImageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DockerServiceServer).TagImage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/alphomega.docker.DockerService/TagImage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DockerServiceServer).TagImage(ctx, req.(*TagImageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DockerService_WatchEvents_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchEventsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(DockerServiceServer).WatchEvents(m, &dockerServiceWatchEventsServer{stream})
+}
+
+type DockerService_WatchEventsServer interface {
+	Send(*WatchEventsResponse) error
+	grpc.ServerStream
+}
+
+type dockerServiceWatchEventsServer struct {
+	grpc.ServerStream
+}
+
+func (x *dockerServiceWatchEventsServer) Send(m *WatchEventsResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _DockerService_GetContainerStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetContainerStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DockerServiceServer).GetContainerStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/alphomega.docker.DockerService/GetContainerStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DockerServiceServer).GetContainerStats(ctx, req.(*GetContainerStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DockerService_WatchContainerStats_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchContainerStatsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(DockerServiceServer).WatchContainerStats(m, &dockerServiceWatchContainerStatsServer{stream})
+}
+
+type DockerService_WatchContainerStatsServer interface {
+	Send(*WatchContainerStatsResponse) error
+	grpc.ServerStream
+}
+
+type dockerServiceWatchContainerStatsServer struct {
+	grpc.ServerStream
+}
+
+func (x *dockerServiceWatchContainerStatsServer) Send(m *WatchContainerStatsResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _DockerService_ExecContainer_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(DockerServiceServer).ExecContainer(&dockerServiceExecContainerServer{stream})
+}
+
+type DockerService_ExecContainerServer interface {
+	Send(*ExecContainerResponse) error
+	Recv() (*ExecContainerRequest, error)
+	grpc.ServerStream
+}
+
+type dockerServiceExecContainerServer struct {
+	grpc.ServerStream
+}
+
+func (x *dockerServiceExecContainerServer) Send(m *ExecContainerResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *dockerServiceExecContainerServer) Recv() (*ExecContainerRequest, error) {
+	m := new(ExecContainerRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _DockerService_CopyToContainer_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(DockerServiceServer).CopyToContainer(&dockerServiceCopyToContainerServer{stream})
+}
+
+type DockerService_CopyToContainerServer interface {
+	SendAndClose(*CopyToContainerResponse) error
+	Recv() (*CopyToContainerRequest, error)
+	grpc.ServerStream
+}
+
+type dockerServiceCopyToContainerServer struct {
+	grpc.ServerStream
+}
+
+func (x *dockerServiceCopyToContainerServer) SendAndClose(m *CopyToContainerResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *dockerServiceCopyToContainerServer) Recv() (*CopyToContainerRequest, error) {
+	m := new(CopyToContainerRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _DockerService_CopyFromContainer_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(CopyFromContainerRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(DockerServiceServer).CopyFromContainer(m, &dockerServiceCopyFromContainerServer{stream})
+}
+
+type DockerService_CopyFromContainerServer interface {
+	Send(*CopyFromContainerResponse) error
+	grpc.ServerStream
+}
+
+type dockerServiceCopyFromContainerServer struct {
+	grpc.ServerStream
+}
+
+func (x *dockerServiceCopyFromContainerServer) Send(m *CopyFromContainerResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _DockerService_StatContainerPath_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StatContainerPathRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DockerServiceServer).StatContainerPath(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/alphomega.docker.DockerService/StatContainerPath",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DockerServiceServer).StatContainerPath(ctx, req.(*StatContainerPathRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DockerService_CommitContainer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CommitContainerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DockerServiceServer).CommitContainer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/alphomega.docker.DockerService/CommitContainer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DockerServiceServer).CommitContainer(ctx, req.(*CommitContainerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DockerService_ListEndpoints_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListEndpointsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DockerServiceServer).ListEndpoints(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/alphomega.docker.DockerService/ListEndpoints",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DockerServiceServer).ListEndpoints(ctx, req.(*ListEndpointsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DockerService_GetEngineStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetEngineStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DockerServiceServer).GetEngineStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/alphomega.docker.DockerService/GetEngineStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DockerServiceServer).GetEngineStatus(ctx, req.(*GetEngineStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DockerService_BuildPackage_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(BuildPackageRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(DockerServiceServer).BuildPackage(m, &dockerServiceBuildPackageServer{stream})
+}
+
+type DockerService_BuildPackageServer interface {
+	Send(*BuildPackageResponse) error
+	grpc.ServerStream
+}
+
+type dockerServiceBuildPackageServer struct {
+	grpc.ServerStream
+}
+
+func (x *dockerServiceBuildPackageServer) Send(m *BuildPackageResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _DockerService_GetManagedContainers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetManagedContainersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DockerServiceServer).GetManagedContainers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/alphomega.docker.DockerService/GetManagedContainers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DockerServiceServer).GetManagedContainers(ctx, req.(*GetManagedContainersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *dockerServiceClient) StartContainer(ctx context.Context, in *StartContainerRequest, opts ...grpc.CallOption) (*StartContainerResponse, error) {
-	out := new(StartContainerResponse)
-	err := c.cc.Invoke(ctx, "/alphomega.docker.DockerService/StartContainer", in, out, opts...)
-	if err != nil {
+func _DockerService_ReconcileContainers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReconcileContainersRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(DockerServiceServer).ReconcileContainers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/alphomega.docker.DockerService/ReconcileContainers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DockerServiceServer).ReconcileContainers(ctx, req.(*ReconcileContainersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *dockerServiceClient) StopContainer(ctx context.Context, in *StopContainerRequest, opts ...grpc.CallOption) (*StopContainerResponse, error) {
-	out := new(StopContainerResponse)
-	err := c.cc.Invoke(ctx, "/alphomega.docker.DockerService/StopContainer", in, out, opts...)
-	if err != nil {
+func _DockerService_CreateNetwork_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateNetworkRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(DockerServiceServer).CreateNetwork(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/alphomega.docker.DockerService/CreateNetwork",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DockerServiceServer).CreateNetwork(ctx, req.(*CreateNetworkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *dockerServiceClient) CreatePackageContainer(ctx context.Context, in *CreatePackageContainerRequest, opts ...grpc.CallOption) (*CreatePackageContainerResponse, error) {
-	out := new(CreatePackageContainerResponse)
-	err := c.cc.Invoke(ctx, "/alphomega.docker.DockerService/CreatePackageContainer", in, out, opts...)
-	if err != nil {
+func _DockerService_GetNetworks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetNetworksRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(DockerServiceServer).GetNetworks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/alphomega.docker.DockerService/GetNetworks",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DockerServiceServer).GetNetworks(ctx, req.(*GetNetworksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *dockerServiceClient) GetPackageVersionContainers(ctx context.Context, in *GetPackageVersionContainersRequest, opts ...grpc.CallOption) (*GetPackageVersionContainersResponse, error) {
-	out := new(GetPackageVersionContainersResponse)
-	err := c.cc.Invoke(ctx, "/alphomega.docker.DockerService/GetPackageVersionContainers", in, out, opts...)
-	if err != nil {
+func _DockerService_InspectNetwork_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InspectNetworkRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
-}
-
-// DockerServiceServer is the server API for DockerService service.
-// All implementations must embed UnimplementedDockerServiceServer
-// for forward compatibility
-type DockerServiceServer interface {
-	DeleteContainer(context.Context, *DeleteContainerRequest) (*DeleteContainerResponse, error)
-	GetContainers(context.Context, *GetContainersRequest) (*GetContainersResponse, error)
-	GetContainerLogs(context.Context, *GetContainerLogsRequest) (*GetContainerLogsResponse, error)
-	StartContainer(context.Context, *StartContainerRequest) (*StartContainerResponse, error)
-	StopContainer(context.Context, *StopContainerRequest) (*StopContainerResponse, error)
-	CreatePackageContainer(context.Context, *CreatePackageContainerRequest) (*CreatePackageContainerResponse, error)
-	GetPackageVersionContainers(context.Context, *GetPackageVersionContainersRequest) (*GetPackageVersionContainersResponse, error)
-	mustEmbedUnimplementedDockerServiceServer()
-}
-
-// UnimplementedDockerServiceServer must be embedded to have forward compatible implementations.
-type UnimplementedDockerServiceServer struct {
+	if interceptor == nil {
+		return srv.(DockerServiceServer).InspectNetwork(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/alphomega.docker.DockerService/InspectNetwork",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DockerServiceServer).InspectNetwork(ctx, req.(*InspectNetworkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (UnimplementedDockerServiceServer) DeleteContainer(context.Context, *DeleteContainerRequest) (*DeleteContainerResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteContainer not implemented")
-}
-func (UnimplementedDockerServiceServer) GetContainers(context.Context, *GetContainersRequest) (*GetContainersResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetContainers not implemented")
-}
-func (UnimplementedDockerServiceServer) GetContainerLogs(context.Context, *GetContainerLogsRequest) (*GetContainerLogsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetContainerLogs not implemented")
-}
-func (UnimplementedDockerServiceServer) StartContainer(context.Context, *StartContainerRequest) (*StartContainerResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method StartContainer not implemented")
-}
-func (UnimplementedDockerServiceServer) StopContainer(context.Context, *StopContainerRequest) (*StopContainerResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method StopContainer not implemented")
-}
-func (UnimplementedDockerServiceServer) CreatePackageContainer(context.Context, *CreatePackageContainerRequest) (*CreatePackageContainerResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreatePackageContainer not implemented")
-}
-func (UnimplementedDockerServiceServer) GetPackageVersionContainers(context.Context, *GetPackageVersionContainersRequest) (*GetPackageVersionContainersResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetPackageVersionContainers not implemented")
+func _DockerService_DeleteNetwork_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteNetworkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DockerServiceServer).DeleteNetwork(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/alphomega.docker.DockerService/DeleteNetwork",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DockerServiceServer).DeleteNetwork(ctx, req.(*DeleteNetworkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedDockerServiceServer) mustEmbedUnimplementedDockerServiceServer() {}
 
-// UnsafeDockerServiceServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to DockerServiceServer will
-// result in compilation errors.
-type UnsafeDockerServiceServer interface {
-	mustEmbedUnimplementedDockerServiceServer()
+func _DockerService_ConnectNetwork_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConnectNetworkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DockerServiceServer).ConnectNetwork(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/alphomega.docker.DockerService/ConnectNetwork",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DockerServiceServer).ConnectNetwork(ctx, req.(*ConnectNetworkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func RegisterDockerServiceServer(s grpc.ServiceRegistrar, srv DockerServiceServer) {
-	s.RegisterService(&DockerService_ServiceDesc, srv)
+func _DockerService_DisconnectNetwork_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DisconnectNetworkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DockerServiceServer).DisconnectNetwork(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/alphomega.docker.DockerService/DisconnectNetwork",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DockerServiceServer).DisconnectNetwork(ctx, req.(*DisconnectNetworkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _DockerService_DeleteContainer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteContainerRequest)
+func _DockerService_CreateVolume_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateVolumeRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DockerServiceServer).DeleteContainer(ctx, in)
+		return srv.(DockerServiceServer).CreateVolume(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/alphomega.docker.DockerService/DeleteContainer",
+		FullMethod: "/alphomega.docker.DockerService/CreateVolume",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DockerServiceServer).DeleteContainer(ctx, req.(*DeleteContainerRequest))
+		return srv.(DockerServiceServer).CreateVolume(ctx, req.(*CreateVolumeRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _DockerService_GetContainers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetContainersRequest)
+func _DockerService_GetVolumes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetVolumesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DockerServiceServer).GetContainers(ctx, in)
+		return srv.(DockerServiceServer).GetVolumes(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/alphomega.docker.DockerService/GetContainers",
+		FullMethod: "/alphomega.docker.DockerService/GetVolumes",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DockerServiceServer).GetContainers(ctx, req.(*GetContainersRequest))
+		return srv.(DockerServiceServer).GetVolumes(ctx, req.(*GetVolumesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _DockerService_GetContainerLogs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetContainerLogsRequest)
+func _DockerService_InspectVolume_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InspectVolumeRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DockerServiceServer).GetContainerLogs(ctx, in)
+		return srv.(DockerServiceServer).InspectVolume(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/alphomega.docker.DockerService/GetContainerLogs",
+		FullMethod: "/alphomega.docker.DockerService/InspectVolume",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DockerServiceServer).GetContainerLogs(ctx, req.(*GetContainerLogsRequest))
+		return srv.(DockerServiceServer).InspectVolume(ctx, req.(*InspectVolumeRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _DockerService_StartContainer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(StartContainerRequest)
+func _DockerService_DeleteVolume_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteVolumeRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DockerServiceServer).StartContainer(ctx, in)
+		return srv.(DockerServiceServer).DeleteVolume(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/alphomega.docker.DockerService/StartContainer",
+		FullMethod: "/alphomega.docker.DockerService/DeleteVolume",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DockerServiceServer).StartContainer(ctx, req.(*StartContainerRequest))
+		return srv.(DockerServiceServer).DeleteVolume(ctx, req.(*DeleteVolumeRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _DockerService_StopContainer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(StopContainerRequest)
+func _DockerService_DeployStack_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeployStackRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DockerServiceServer).StopContainer(ctx, in)
+		return srv.(DockerServiceServer).DeployStack(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/alphomega.docker.DockerService/StopContainer",
+		FullMethod: "/alphomega.docker.DockerService/DeployStack",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DockerServiceServer).StopContainer(ctx, req.(*StopContainerRequest))
+		return srv.(DockerServiceServer).DeployStack(ctx, req.(*DeployStackRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _DockerService_CreatePackageContainer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreatePackageContainerRequest)
+func _DockerService_GetStack_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetStackRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DockerServiceServer).CreatePackageContainer(ctx, in)
+		return srv.(DockerServiceServer).GetStack(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/alphomega.docker.DockerService/CreatePackageContainer",
+		FullMethod: "/alphomega.docker.DockerService/GetStack",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DockerServiceServer).CreatePackageContainer(ctx, req.(*CreatePackageContainerRequest))
+		return srv.(DockerServiceServer).GetStack(ctx, req.(*GetStackRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _DockerService_GetPackageVersionContainers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetPackageVersionContainersRequest)
+func _DockerService_RemoveStack_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveStackRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DockerServiceServer).GetPackageVersionContainers(ctx, in)
+		return srv.(DockerServiceServer).RemoveStack(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/alphomega.docker.DockerService/GetPackageVersionContainers",
+		FullMethod: "/alphomega.docker.DockerService/RemoveStack",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DockerServiceServer).GetPackageVersionContainers(ctx, req.(*GetPackageVersionContainersRequest))
+		return srv.(DockerServiceServer).RemoveStack(ctx, req.(*RemoveStackRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -295,6 +1852,10 @@ var DockerService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetContainers",
 			Handler:    _DockerService_GetContainers_Handler,
 		},
+		{
+			MethodName: "InspectContainer",
+			Handler:    _DockerService_InspectContainer_Handler,
+		},
 		{
 			MethodName: "GetContainerLogs",
 			Handler:    _DockerService_GetContainerLogs_Handler,
@@ -307,6 +1868,30 @@ var DockerService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "StopContainer",
 			Handler:    _DockerService_StopContainer_Handler,
 		},
+		{
+			MethodName: "RestartContainer",
+			Handler:    _DockerService_RestartContainer_Handler,
+		},
+		{
+			MethodName: "KillContainer",
+			Handler:    _DockerService_KillContainer_Handler,
+		},
+		{
+			MethodName: "PauseContainer",
+			Handler:    _DockerService_PauseContainer_Handler,
+		},
+		{
+			MethodName: "UnpauseContainer",
+			Handler:    _DockerService_UnpauseContainer_Handler,
+		},
+		{
+			MethodName: "RenameContainer",
+			Handler:    _DockerService_RenameContainer_Handler,
+		},
+		{
+			MethodName: "UpdateContainer",
+			Handler:    _DockerService_UpdateContainer_Handler,
+		},
 		{
 			MethodName: "CreatePackageContainer",
 			Handler:    _DockerService_CreatePackageContainer_Handler,
@@ -315,7 +1900,154 @@ var DockerService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetPackageVersionContainers",
 			Handler:    _DockerService_GetPackageVersionContainers_Handler,
 		},
+		{
+			MethodName: "GetImages",
+			Handler:    _DockerService_GetImages_Handler,
+		},
+		{
+			MethodName: "InspectImage",
+			Handler:    _DockerService_InspectImage_Handler,
+		},
+		{
+			MethodName: "RemoveImage",
+			Handler:    _DockerService_RemoveImage_Handler,
+		},
+		{
+			MethodName: "PruneImages",
+			Handler:    _DockerService_PruneImages_Handler,
+		},
+		{
+			MethodName: "TagImage",
+			Handler:    _DockerService_TagImage_Handler,
+		},
+		{
+			MethodName: "GetContainerStats",
+			Handler:    _DockerService_GetContainerStats_Handler,
+		},
+		{
+			MethodName: "StatContainerPath",
+			Handler:    _DockerService_StatContainerPath_Handler,
+		},
+		{
+			MethodName: "CommitContainer",
+			Handler:    _DockerService_CommitContainer_Handler,
+		},
+		{
+			MethodName: "ListEndpoints",
+			Handler:    _DockerService_ListEndpoints_Handler,
+		},
+		{
+			MethodName: "GetEngineStatus",
+			Handler:    _DockerService_GetEngineStatus_Handler,
+		},
+		{
+			MethodName: "GetManagedContainers",
+			Handler:    _DockerService_GetManagedContainers_Handler,
+		},
+		{
+			MethodName: "ReconcileContainers",
+			Handler:    _DockerService_ReconcileContainers_Handler,
+		},
+		{
+			MethodName: "CreateNetwork",
+			Handler:    _DockerService_CreateNetwork_Handler,
+		},
+		{
+			MethodName: "GetNetworks",
+			Handler:    _DockerService_GetNetworks_Handler,
+		},
+		{
+			MethodName: "InspectNetwork",
+			Handler:    _DockerService_InspectNetwork_Handler,
+		},
+		{
+			MethodName: "DeleteNetwork",
+			Handler:    _DockerService_DeleteNetwork_Handler,
+		},
+		{
+			MethodName: "ConnectNetwork",
+			Handler:    _DockerService_ConnectNetwork_Handler,
+		},
+		{
+			MethodName: "DisconnectNetwork",
+			Handler:    _DockerService_DisconnectNetwork_Handler,
+		},
+		{
+			MethodName: "CreateVolume",
+			Handler:    _DockerService_CreateVolume_Handler,
+		},
+		{
+			MethodName: "GetVolumes",
+			Handler:    _DockerService_GetVolumes_Handler,
+		},
+		{
+			MethodName: "InspectVolume",
+			Handler:    _DockerService_InspectVolume_Handler,
+		},
+		{
+			MethodName: "DeleteVolume",
+			Handler:    _DockerService_DeleteVolume_Handler,
+		},
+		{
+			MethodName: "DeployStack",
+			Handler:    _DockerService_DeployStack_Handler,
+		},
+		{
+			MethodName: "GetStack",
+			Handler:    _DockerService_GetStack_Handler,
+		},
+		{
+			MethodName: "RemoveStack",
+			Handler:    _DockerService_RemoveStack_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamContainerLogs",
+			Handler:       _DockerService_StreamContainerLogs_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "PullImage",
+			Handler:       _DockerService_PullImage_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "PushImage",
+			Handler:       _DockerService_PushImage_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "WatchEvents",
+			Handler:       _DockerService_WatchEvents_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "WatchContainerStats",
+			Handler:       _DockerService_WatchContainerStats_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ExecContainer",
+			Handler:       _DockerService_ExecContainer_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "CopyToContainer",
+			Handler:       _DockerService_CopyToContainer_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "CopyFromContainer",
+			Handler:       _DockerService_CopyFromContainer_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "BuildPackage",
+			Handler:       _DockerService_BuildPackage_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "proto/docker/docker.proto",
 }
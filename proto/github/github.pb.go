@@ -21,6 +21,104 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type SecretStore int32
+
+const (
+	SecretStore_ACTIONS    SecretStore = 0
+	SecretStore_DEPENDABOT SecretStore = 1
+	SecretStore_CODESPACES SecretStore = 2
+)
+
+// Enum value maps for SecretStore.
+var (
+	SecretStore_name = map[int32]string{
+		0: "ACTIONS",
+		1: "DEPENDABOT",
+		2: "CODESPACES",
+	}
+	SecretStore_value = map[string]int32{
+		"ACTIONS":    0,
+		"DEPENDABOT": 1,
+		"CODESPACES": 2,
+	}
+)
+
+func (x SecretStore) Enum() *SecretStore {
+	p := new(SecretStore)
+	*p = x
+	return p
+}
+
+func (x SecretStore) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SecretStore) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_github_github_proto_enumTypes[0].Descriptor()
+}
+
+func (SecretStore) Type() protoreflect.EnumType {
+	return &file_proto_github_github_proto_enumTypes[0]
+}
+
+func (x SecretStore) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SecretStore.Descriptor instead.
+func (SecretStore) EnumDescriptor() ([]byte, []int) {
+	return file_proto_github_github_proto_rawDescGZIP(), []int{0}
+}
+
+type ScopeType int32
+
+const (
+	ScopeType_ORG         ScopeType = 0
+	ScopeType_REPOSITORY  ScopeType = 1
+	ScopeType_ENVIRONMENT ScopeType = 2
+)
+
+// Enum value maps for ScopeType.
+var (
+	ScopeType_name = map[int32]string{
+		0: "ORG",
+		1: "REPOSITORY",
+		2: "ENVIRONMENT",
+	}
+	ScopeType_value = map[string]int32{
+		"ORG":         0,
+		"REPOSITORY":  1,
+		"ENVIRONMENT": 2,
+	}
+)
+
+func (x ScopeType) Enum() *ScopeType {
+	p := new(ScopeType)
+	*p = x
+	return p
+}
+
+func (x ScopeType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ScopeType) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_github_github_proto_enumTypes[1].Descriptor()
+}
+
+func (ScopeType) Type() protoreflect.EnumType {
+	return &file_proto_github_github_proto_enumTypes[1]
+}
+
+func (x ScopeType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ScopeType.Descriptor instead.
+func (ScopeType) EnumDescriptor() ([]byte, []int) {
+	return file_proto_github_github_proto_rawDescGZIP(), []int{1}
+}
+
 type DeletePackageRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -73,7 +171,8 @@ type DeletePackageResponse struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Status int64 `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
+	Status int64  `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
+	JobId  string `protobuf:"bytes,2,opt,name=jobId,proto3" json:"jobId,omitempty"`
 }
 
 func (x *DeletePackageResponse) Reset() {
@@ -115,12 +214,20 @@ func (x *DeletePackageResponse) GetStatus() int64 {
 	return 0
 }
 
+func (x *DeletePackageResponse) GetJobId() string {
+	if x != nil {
+		return x.JobId
+	}
+	return ""
+}
+
 type GetSecretContentRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Name  string       `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Scope *SecretScope `protobuf:"bytes,2,opt,name=scope,proto3" json:"scope,omitempty"`
 }
 
 func (x *GetSecretContentRequest) Reset() {
@@ -162,6 +269,13 @@ func (x *GetSecretContentRequest) GetName() string {
 	return ""
 }
 
+func (x *GetSecretContentRequest) GetScope() *SecretScope {
+	if x != nil {
+		return x.Scope
+	}
+	return nil
+}
+
 type GetSecretContentResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -213,6 +327,9 @@ type SyncEnvironmentRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	// the secrets and variables of the repository override the org ones of the same name
+	Repository string `protobuf:"bytes,1,opt,name=repository,proto3" json:"repository,omitempty"`
 }
 
 func (x *SyncEnvironmentRequest) Reset() {
@@ -247,6 +364,13 @@ func (*SyncEnvironmentRequest) Descriptor() ([]byte, []int) {
 	return file_proto_github_github_proto_rawDescGZIP(), []int{4}
 }
 
+func (x *SyncEnvironmentRequest) GetRepository() string {
+	if x != nil {
+		return x.Repository
+	}
+	return ""
+}
+
 type SyncEnvironmentResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -299,7 +423,8 @@ type DeleteSecretRequest struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Name  string       `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Scope *SecretScope `protobuf:"bytes,2,opt,name=scope,proto3" json:"scope,omitempty"`
 }
 
 func (x *DeleteSecretRequest) Reset() {
@@ -341,6 +466,13 @@ func (x *DeleteSecretRequest) GetName() string {
 	return ""
 }
 
+func (x *DeleteSecretRequest) GetScope() *SecretScope {
+	if x != nil {
+		return x.Scope
+	}
+	return nil
+}
+
 type DeleteSecretResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -393,8 +525,11 @@ type CreateSecretRequest struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name    string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Content []byte `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
+	Name         string       `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Content      []byte       `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
+	Scope        *SecretScope `protobuf:"bytes,3,opt,name=scope,proto3" json:"scope,omitempty"`
+	Visibility   string       `protobuf:"bytes,4,opt,name=visibility,proto3" json:"visibility,omitempty"`
+	Repositories []string     `protobuf:"bytes,5,rep,name=repositories,proto3" json:"repositories,omitempty"`
 }
 
 func (x *CreateSecretRequest) Reset() {
@@ -443,6 +578,27 @@ func (x *CreateSecretRequest) GetContent() []byte {
 	return nil
 }
 
+func (x *CreateSecretRequest) GetScope() *SecretScope {
+	if x != nil {
+		return x.Scope
+	}
+	return nil
+}
+
+func (x *CreateSecretRequest) GetVisibility() string {
+	if x != nil {
+		return x.Visibility
+	}
+	return ""
+}
+
+func (x *CreateSecretRequest) GetRepositories() []string {
+	if x != nil {
+		return x.Repositories
+	}
+	return nil
+}
+
 type CreateSecretResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -494,6 +650,8 @@ type GetSecretsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Scope *SecretScope `protobuf:"bytes,1,opt,name=scope,proto3" json:"scope,omitempty"`
 }
 
 func (x *GetSecretsRequest) Reset() {
@@ -528,6 +686,13 @@ func (*GetSecretsRequest) Descriptor() ([]byte, []int) {
 	return file_proto_github_github_proto_rawDescGZIP(), []int{10}
 }
 
+func (x *GetSecretsRequest) GetScope() *SecretScope {
+	if x != nil {
+		return x.Scope
+	}
+	return nil
+}
+
 type GetSecretsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -580,10 +745,11 @@ type Secret struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name       string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	CreatedAt  string `protobuf:"bytes,2,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
-	UpdatedAt  string `protobuf:"bytes,3,opt,name=updatedAt,proto3" json:"updatedAt,omitempty"`
-	Visibility string `protobuf:"bytes,4,opt,name=visibility,proto3" json:"visibility,omitempty"`
+	Name         string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	CreatedAt    string   `protobuf:"bytes,2,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	UpdatedAt    string   `protobuf:"bytes,3,opt,name=updatedAt,proto3" json:"updatedAt,omitempty"`
+	Visibility   string   `protobuf:"bytes,4,opt,name=visibility,proto3" json:"visibility,omitempty"`
+	Repositories []string `protobuf:"bytes,5,rep,name=repositories,proto3" json:"repositories,omitempty"`
 }
 
 func (x *Secret) Reset() {
@@ -646,18 +812,26 @@ func (x *Secret) GetVisibility() string {
 	return ""
 }
 
-type Package struct {
+func (x *Secret) GetRepositories() []string {
+	if x != nil {
+		return x.Repositories
+	}
+	return nil
+}
+
+type SecretScope struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name       string              `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Files      []*File             `protobuf:"bytes,2,rep,name=files,proto3" json:"files,omitempty"`
-	Containers []*docker.Container `protobuf:"bytes,3,rep,name=containers,proto3" json:"containers,omitempty"`
+	Type        ScopeType   `protobuf:"varint,1,opt,name=type,proto3,enum=alphomega.github.ScopeType" json:"type,omitempty"`
+	Repository  string      `protobuf:"bytes,2,opt,name=repository,proto3" json:"repository,omitempty"`
+	Environment string      `protobuf:"bytes,3,opt,name=environment,proto3" json:"environment,omitempty"`
+	Store       SecretStore `protobuf:"varint,4,opt,name=store,proto3,enum=alphomega.github.SecretStore" json:"store,omitempty"`
 }
 
-func (x *Package) Reset() {
-	*x = Package{}
+func (x *SecretScope) Reset() {
+	*x = SecretScope{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_github_github_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -665,13 +839,13 @@ func (x *Package) Reset() {
 	}
 }
 
-func (x *Package) String() string {
+func (x *SecretScope) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Package) ProtoMessage() {}
+func (*SecretScope) ProtoMessage() {}
 
-func (x *Package) ProtoReflect() protoreflect.Message {
+func (x *SecretScope) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_github_github_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -683,49 +857,51 @@ func (x *Package) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Package.ProtoReflect.Descriptor instead.
-func (*Package) Descriptor() ([]byte, []int) {
+// Deprecated: Use SecretScope.ProtoReflect.Descriptor instead.
+func (*SecretScope) Descriptor() ([]byte, []int) {
 	return file_proto_github_github_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *Package) GetName() string {
+func (x *SecretScope) GetType() ScopeType {
 	if x != nil {
-		return x.Name
+		return x.Type
+	}
+	return ScopeType_ORG
+}
+
+func (x *SecretScope) GetRepository() string {
+	if x != nil {
+		return x.Repository
 	}
 	return ""
 }
 
-func (x *Package) GetFiles() []*File {
+func (x *SecretScope) GetEnvironment() string {
 	if x != nil {
-		return x.Files
+		return x.Environment
 	}
-	return nil
+	return ""
 }
 
-func (x *Package) GetContainers() []*docker.Container {
+func (x *SecretScope) GetStore() SecretStore {
 	if x != nil {
-		return x.Containers
+		return x.Store
 	}
-	return nil
+	return SecretStore_ACTIONS
 }
 
-type SimplePackage struct {
+type GetSecretHistoryRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Type        string `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
-	Size        int64  `protobuf:"varint,2,opt,name=size,proto3" json:"size,omitempty"`
-	Name        string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
-	Path        string `protobuf:"bytes,4,opt,name=path,proto3" json:"path,omitempty"`
-	Sha         string `protobuf:"bytes,5,opt,name=sha,proto3" json:"sha,omitempty"`
-	GitUrl      string `protobuf:"bytes,6,opt,name=gitUrl,proto3" json:"gitUrl,omitempty"`
-	HtmlUrl     string `protobuf:"bytes,7,opt,name=htmlUrl,proto3" json:"htmlUrl,omitempty"`
-	DownloadUrl string `protobuf:"bytes,8,opt,name=downloadUrl,proto3" json:"downloadUrl,omitempty"`
+	Name        string       `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	WithContent bool         `protobuf:"varint,2,opt,name=withContent,proto3" json:"withContent,omitempty"`
+	Scope       *SecretScope `protobuf:"bytes,3,opt,name=scope,proto3" json:"scope,omitempty"`
 }
 
-func (x *SimplePackage) Reset() {
-	*x = SimplePackage{}
+func (x *GetSecretHistoryRequest) Reset() {
+	*x = GetSecretHistoryRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_github_github_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -733,13 +909,13 @@ func (x *SimplePackage) Reset() {
 	}
 }
 
-func (x *SimplePackage) String() string {
+func (x *GetSecretHistoryRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SimplePackage) ProtoMessage() {}
+func (*GetSecretHistoryRequest) ProtoMessage() {}
 
-func (x *SimplePackage) ProtoReflect() protoreflect.Message {
+func (x *GetSecretHistoryRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_github_github_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -751,102 +927,110 @@ func (x *SimplePackage) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SimplePackage.ProtoReflect.Descriptor instead.
-func (*SimplePackage) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetSecretHistoryRequest.ProtoReflect.Descriptor instead.
+func (*GetSecretHistoryRequest) Descriptor() ([]byte, []int) {
 	return file_proto_github_github_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *SimplePackage) GetType() string {
+func (x *GetSecretHistoryRequest) GetName() string {
 	if x != nil {
-		return x.Type
+		return x.Name
 	}
 	return ""
 }
 
-func (x *SimplePackage) GetSize() int64 {
+func (x *GetSecretHistoryRequest) GetWithContent() bool {
 	if x != nil {
-		return x.Size
+		return x.WithContent
 	}
-	return 0
+	return false
 }
 
-func (x *SimplePackage) GetName() string {
+func (x *GetSecretHistoryRequest) GetScope() *SecretScope {
 	if x != nil {
-		return x.Name
+		return x.Scope
 	}
-	return ""
+	return nil
 }
 
-func (x *SimplePackage) GetPath() string {
-	if x != nil {
-		return x.Path
-	}
-	return ""
+type GetSecretHistoryResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Versions []*SecretVersion `protobuf:"bytes,1,rep,name=versions,proto3" json:"versions,omitempty"`
 }
 
-func (x *SimplePackage) GetSha() string {
-	if x != nil {
-		return x.Sha
+func (x *GetSecretHistoryResponse) Reset() {
+	*x = GetSecretHistoryResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_github_github_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return ""
 }
 
-func (x *SimplePackage) GetGitUrl() string {
-	if x != nil {
-		return x.GitUrl
-	}
-	return ""
+func (x *GetSecretHistoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *SimplePackage) GetHtmlUrl() string {
-	if x != nil {
-		return x.HtmlUrl
+func (*GetSecretHistoryResponse) ProtoMessage() {}
+
+func (x *GetSecretHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_github_github_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *SimplePackage) GetDownloadUrl() string {
+// Deprecated: Use GetSecretHistoryResponse.ProtoReflect.Descriptor instead.
+func (*GetSecretHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_proto_github_github_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *GetSecretHistoryResponse) GetVersions() []*SecretVersion {
 	if x != nil {
-		return x.DownloadUrl
+		return x.Versions
 	}
-	return ""
+	return nil
 }
 
-type GitPackage struct {
+type SecretVersion struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id         int64  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name       string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Type       string `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
-	Version    int64  `protobuf:"varint,4,opt,name=version,proto3" json:"version,omitempty"`
-	Visibility string `protobuf:"bytes,5,opt,name=visibility,proto3" json:"visibility,omitempty"`
-	Url        string `protobuf:"bytes,6,opt,name=url,proto3" json:"url,omitempty"`
-	HtmlUrl    string `protobuf:"bytes,7,opt,name=htmlUrl,proto3" json:"htmlUrl,omitempty"`
-	OwnerId    int64  `protobuf:"varint,8,opt,name=ownerId,proto3" json:"ownerId,omitempty"`
-	OwnerName  string `protobuf:"bytes,9,opt,name=ownerName,proto3" json:"ownerName,omitempty"`
-	OwnerNode  string `protobuf:"bytes,10,opt,name=ownerNode,proto3" json:"ownerNode,omitempty"`
-	OwnerType  string `protobuf:"bytes,11,opt,name=ownerType,proto3" json:"ownerType,omitempty"`
+	Version        int64    `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+	Action         string   `protobuf:"bytes,2,opt,name=action,proto3" json:"action,omitempty"`
+	Content        []byte   `protobuf:"bytes,3,opt,name=content,proto3" json:"content,omitempty"`
+	RolledBackFrom int64    `protobuf:"varint,4,opt,name=rolledBackFrom,proto3" json:"rolledBackFrom,omitempty"`
+	Created        string   `protobuf:"bytes,5,opt,name=created,proto3" json:"created,omitempty"`
+	Visibility     string   `protobuf:"bytes,6,opt,name=visibility,proto3" json:"visibility,omitempty"`
+	Repositories   []string `protobuf:"bytes,7,rep,name=repositories,proto3" json:"repositories,omitempty"`
 }
 
-func (x *GitPackage) Reset() {
-	*x = GitPackage{}
+func (x *SecretVersion) Reset() {
+	*x = SecretVersion{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_github_github_proto_msgTypes[15]
+		mi := &file_proto_github_github_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GitPackage) String() string {
+func (x *SecretVersion) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GitPackage) ProtoMessage() {}
+func (*SecretVersion) ProtoMessage() {}
 
-func (x *GitPackage) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_github_github_proto_msgTypes[15]
+func (x *SecretVersion) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_github_github_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -857,114 +1041,87 @@ func (x *GitPackage) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GitPackage.ProtoReflect.Descriptor instead.
-func (*GitPackage) Descriptor() ([]byte, []int) {
-	return file_proto_github_github_proto_rawDescGZIP(), []int{15}
+// Deprecated: Use SecretVersion.ProtoReflect.Descriptor instead.
+func (*SecretVersion) Descriptor() ([]byte, []int) {
+	return file_proto_github_github_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *GitPackage) GetId() int64 {
+func (x *SecretVersion) GetVersion() int64 {
 	if x != nil {
-		return x.Id
+		return x.Version
 	}
 	return 0
 }
 
-func (x *GitPackage) GetName() string {
+func (x *SecretVersion) GetAction() string {
 	if x != nil {
-		return x.Name
+		return x.Action
 	}
 	return ""
 }
 
-func (x *GitPackage) GetType() string {
+func (x *SecretVersion) GetContent() []byte {
 	if x != nil {
-		return x.Type
+		return x.Content
 	}
-	return ""
+	return nil
 }
 
-func (x *GitPackage) GetVersion() int64 {
+func (x *SecretVersion) GetRolledBackFrom() int64 {
 	if x != nil {
-		return x.Version
+		return x.RolledBackFrom
 	}
 	return 0
 }
 
-func (x *GitPackage) GetVisibility() string {
+func (x *SecretVersion) GetCreated() string {
 	if x != nil {
-		return x.Visibility
+		return x.Created
 	}
 	return ""
 }
 
-func (x *GitPackage) GetUrl() string {
+func (x *SecretVersion) GetVisibility() string {
 	if x != nil {
-		return x.Url
+		return x.Visibility
 	}
 	return ""
 }
 
-func (x *GitPackage) GetHtmlUrl() string {
+func (x *SecretVersion) GetRepositories() []string {
 	if x != nil {
-		return x.HtmlUrl
+		return x.Repositories
 	}
-	return ""
+	return nil
 }
 
-func (x *GitPackage) GetOwnerId() int64 {
-	if x != nil {
-		return x.OwnerId
-	}
-	return 0
+type RollbackSecretRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name    string       `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Version int64        `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+	Scope   *SecretScope `protobuf:"bytes,3,opt,name=scope,proto3" json:"scope,omitempty"`
 }
 
-func (x *GitPackage) GetOwnerName() string {
-	if x != nil {
-		return x.OwnerName
+func (x *RollbackSecretRequest) Reset() {
+	*x = RollbackSecretRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_github_github_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return ""
 }
 
-func (x *GitPackage) GetOwnerNode() string {
-	if x != nil {
-		return x.OwnerNode
-	}
-	return ""
-}
-
-func (x *GitPackage) GetOwnerType() string {
-	if x != nil {
-		return x.OwnerType
-	}
-	return ""
-}
-
-type File struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Name    string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Content []byte `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
-}
-
-func (x *File) Reset() {
-	*x = File{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_github_github_proto_msgTypes[16]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
-}
-
-func (x *File) String() string {
+func (x *RollbackSecretRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*File) ProtoMessage() {}
+func (*RollbackSecretRequest) ProtoMessage() {}
 
-func (x *File) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_github_github_proto_msgTypes[16]
+func (x *RollbackSecretRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_github_github_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -975,51 +1132,58 @@ func (x *File) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use File.ProtoReflect.Descriptor instead.
-func (*File) Descriptor() ([]byte, []int) {
-	return file_proto_github_github_proto_rawDescGZIP(), []int{16}
+// Deprecated: Use RollbackSecretRequest.ProtoReflect.Descriptor instead.
+func (*RollbackSecretRequest) Descriptor() ([]byte, []int) {
+	return file_proto_github_github_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *File) GetName() string {
+func (x *RollbackSecretRequest) GetName() string {
 	if x != nil {
 		return x.Name
 	}
 	return ""
 }
 
-func (x *File) GetContent() []byte {
+func (x *RollbackSecretRequest) GetVersion() int64 {
 	if x != nil {
-		return x.Content
+		return x.Version
+	}
+	return 0
+}
+
+func (x *RollbackSecretRequest) GetScope() *SecretScope {
+	if x != nil {
+		return x.Scope
 	}
 	return nil
 }
 
-type PackageIdentifier struct {
+type RollbackSecretResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Tag  string `protobuf:"bytes,2,opt,name=tag,proto3" json:"tag,omitempty"`
+	Status  int64 `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
+	Version int64 `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
 }
 
-func (x *PackageIdentifier) Reset() {
-	*x = PackageIdentifier{}
+func (x *RollbackSecretResponse) Reset() {
+	*x = RollbackSecretResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_github_github_proto_msgTypes[17]
+		mi := &file_proto_github_github_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *PackageIdentifier) String() string {
+func (x *RollbackSecretResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PackageIdentifier) ProtoMessage() {}
+func (*RollbackSecretResponse) ProtoMessage() {}
 
-func (x *PackageIdentifier) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_github_github_proto_msgTypes[17]
+func (x *RollbackSecretResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_github_github_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1030,50 +1194,51 @@ func (x *PackageIdentifier) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PackageIdentifier.ProtoReflect.Descriptor instead.
-func (*PackageIdentifier) Descriptor() ([]byte, []int) {
-	return file_proto_github_github_proto_rawDescGZIP(), []int{17}
+// Deprecated: Use RollbackSecretResponse.ProtoReflect.Descriptor instead.
+func (*RollbackSecretResponse) Descriptor() ([]byte, []int) {
+	return file_proto_github_github_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *PackageIdentifier) GetName() string {
+func (x *RollbackSecretResponse) GetStatus() int64 {
 	if x != nil {
-		return x.Name
+		return x.Status
 	}
-	return ""
+	return 0
 }
 
-func (x *PackageIdentifier) GetTag() string {
+func (x *RollbackSecretResponse) GetVersion() int64 {
 	if x != nil {
-		return x.Tag
+		return x.Version
 	}
-	return ""
+	return 0
 }
 
-type GetPackageTagsRequest struct {
+type GetSecretRepositoriesRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Name  string      `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Store SecretStore `protobuf:"varint,2,opt,name=store,proto3,enum=alphomega.github.SecretStore" json:"store,omitempty"`
 }
 
-func (x *GetPackageTagsRequest) Reset() {
-	*x = GetPackageTagsRequest{}
+func (x *GetSecretRepositoriesRequest) Reset() {
+	*x = GetSecretRepositoriesRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_github_github_proto_msgTypes[18]
+		mi := &file_proto_github_github_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetPackageTagsRequest) String() string {
+func (x *GetSecretRepositoriesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetPackageTagsRequest) ProtoMessage() {}
+func (*GetSecretRepositoriesRequest) ProtoMessage() {}
 
-func (x *GetPackageTagsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_github_github_proto_msgTypes[18]
+func (x *GetSecretRepositoriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_github_github_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1084,76 +1249,35 @@ func (x *GetPackageTagsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetPackageTagsRequest.ProtoReflect.Descriptor instead.
-func (*GetPackageTagsRequest) Descriptor() ([]byte, []int) {
-	return file_proto_github_github_proto_rawDescGZIP(), []int{18}
+// Deprecated: Use GetSecretRepositoriesRequest.ProtoReflect.Descriptor instead.
+func (*GetSecretRepositoriesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_github_github_proto_rawDescGZIP(), []int{19}
 }
 
-func (x *GetPackageTagsRequest) GetName() string {
+func (x *GetSecretRepositoriesRequest) GetName() string {
 	if x != nil {
 		return x.Name
 	}
 	return ""
 }
 
-type GetPackageTagsResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Tags []string `protobuf:"bytes,1,rep,name=tags,proto3" json:"tags,omitempty"`
-}
-
-func (x *GetPackageTagsResponse) Reset() {
-	*x = GetPackageTagsResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_github_github_proto_msgTypes[19]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
-}
-
-func (x *GetPackageTagsResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*GetPackageTagsResponse) ProtoMessage() {}
-
-func (x *GetPackageTagsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_github_github_proto_msgTypes[19]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use GetPackageTagsResponse.ProtoReflect.Descriptor instead.
-func (*GetPackageTagsResponse) Descriptor() ([]byte, []int) {
-	return file_proto_github_github_proto_rawDescGZIP(), []int{19}
-}
-
-func (x *GetPackageTagsResponse) GetTags() []string {
+func (x *GetSecretRepositoriesRequest) GetStore() SecretStore {
 	if x != nil {
-		return x.Tags
+		return x.Store
 	}
-	return nil
+	return SecretStore_ACTIONS
 }
 
-type PackageTag struct {
+type GetSecretRepositoriesResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Tag  string `protobuf:"bytes,2,opt,name=tag,proto3" json:"tag,omitempty"`
+	Repositories []string `protobuf:"bytes,1,rep,name=repositories,proto3" json:"repositories,omitempty"`
 }
 
-func (x *PackageTag) Reset() {
-	*x = PackageTag{}
+func (x *GetSecretRepositoriesResponse) Reset() {
+	*x = GetSecretRepositoriesResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_github_github_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1161,13 +1285,13 @@ func (x *PackageTag) Reset() {
 	}
 }
 
-func (x *PackageTag) String() string {
+func (x *GetSecretRepositoriesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PackageTag) ProtoMessage() {}
+func (*GetSecretRepositoriesResponse) ProtoMessage() {}
 
-func (x *PackageTag) ProtoReflect() protoreflect.Message {
+func (x *GetSecretRepositoriesResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_github_github_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1179,37 +1303,30 @@ func (x *PackageTag) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PackageTag.ProtoReflect.Descriptor instead.
-func (*PackageTag) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetSecretRepositoriesResponse.ProtoReflect.Descriptor instead.
+func (*GetSecretRepositoriesResponse) Descriptor() ([]byte, []int) {
 	return file_proto_github_github_proto_rawDescGZIP(), []int{20}
 }
 
-func (x *PackageTag) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
-}
-
-func (x *PackageTag) GetTag() string {
+func (x *GetSecretRepositoriesResponse) GetRepositories() []string {
 	if x != nil {
-		return x.Tag
+		return x.Repositories
 	}
-	return ""
+	return nil
 }
 
-type DeletePackageVersionRequest struct {
+type AddSecretRepositoryRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name    string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Tag     string `protobuf:"bytes,2,opt,name=tag,proto3" json:"tag,omitempty"`
-	Version *int64 `protobuf:"varint,3,opt,name=version,proto3,oneof" json:"version,omitempty"`
+	Name       string      `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Repository string      `protobuf:"bytes,2,opt,name=repository,proto3" json:"repository,omitempty"`
+	Store      SecretStore `protobuf:"varint,3,opt,name=store,proto3,enum=alphomega.github.SecretStore" json:"store,omitempty"`
 }
 
-func (x *DeletePackageVersionRequest) Reset() {
-	*x = DeletePackageVersionRequest{}
+func (x *AddSecretRepositoryRequest) Reset() {
+	*x = AddSecretRepositoryRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_github_github_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1217,13 +1334,13 @@ func (x *DeletePackageVersionRequest) Reset() {
 	}
 }
 
-func (x *DeletePackageVersionRequest) String() string {
+func (x *AddSecretRepositoryRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeletePackageVersionRequest) ProtoMessage() {}
+func (*AddSecretRepositoryRequest) ProtoMessage() {}
 
-func (x *DeletePackageVersionRequest) ProtoReflect() protoreflect.Message {
+func (x *AddSecretRepositoryRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_github_github_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1235,33 +1352,33 @@ func (x *DeletePackageVersionRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeletePackageVersionRequest.ProtoReflect.Descriptor instead.
-func (*DeletePackageVersionRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use AddSecretRepositoryRequest.ProtoReflect.Descriptor instead.
+func (*AddSecretRepositoryRequest) Descriptor() ([]byte, []int) {
 	return file_proto_github_github_proto_rawDescGZIP(), []int{21}
 }
 
-func (x *DeletePackageVersionRequest) GetName() string {
+func (x *AddSecretRepositoryRequest) GetName() string {
 	if x != nil {
 		return x.Name
 	}
 	return ""
 }
 
-func (x *DeletePackageVersionRequest) GetTag() string {
+func (x *AddSecretRepositoryRequest) GetRepository() string {
 	if x != nil {
-		return x.Tag
+		return x.Repository
 	}
 	return ""
 }
 
-func (x *DeletePackageVersionRequest) GetVersion() int64 {
-	if x != nil && x.Version != nil {
-		return *x.Version
+func (x *AddSecretRepositoryRequest) GetStore() SecretStore {
+	if x != nil {
+		return x.Store
 	}
-	return 0
+	return SecretStore_ACTIONS
 }
 
-type DeletePackageVersionResponse struct {
+type AddSecretRepositoryResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
@@ -1269,8 +1386,8 @@ type DeletePackageVersionResponse struct {
 	Status int64 `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
 }
 
-func (x *DeletePackageVersionResponse) Reset() {
-	*x = DeletePackageVersionResponse{}
+func (x *AddSecretRepositoryResponse) Reset() {
+	*x = AddSecretRepositoryResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_github_github_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1278,13 +1395,13 @@ func (x *DeletePackageVersionResponse) Reset() {
 	}
 }
 
-func (x *DeletePackageVersionResponse) String() string {
+func (x *AddSecretRepositoryResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeletePackageVersionResponse) ProtoMessage() {}
+func (*AddSecretRepositoryResponse) ProtoMessage() {}
 
-func (x *DeletePackageVersionResponse) ProtoReflect() protoreflect.Message {
+func (x *AddSecretRepositoryResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_github_github_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1296,30 +1413,30 @@ func (x *DeletePackageVersionResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeletePackageVersionResponse.ProtoReflect.Descriptor instead.
-func (*DeletePackageVersionResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use AddSecretRepositoryResponse.ProtoReflect.Descriptor instead.
+func (*AddSecretRepositoryResponse) Descriptor() ([]byte, []int) {
 	return file_proto_github_github_proto_rawDescGZIP(), []int{22}
 }
 
-func (x *DeletePackageVersionResponse) GetStatus() int64 {
+func (x *AddSecretRepositoryResponse) GetStatus() int64 {
 	if x != nil {
 		return x.Status
 	}
 	return 0
 }
 
-type CreatePackageVersionRequest struct {
+type RemoveSecretRepositoryRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name    string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Tag     string `protobuf:"bytes,2,opt,name=tag,proto3" json:"tag,omitempty"`
-	Content []byte `protobuf:"bytes,3,opt,name=content,proto3" json:"content,omitempty"`
+	Name       string      `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Repository string      `protobuf:"bytes,2,opt,name=repository,proto3" json:"repository,omitempty"`
+	Store      SecretStore `protobuf:"varint,3,opt,name=store,proto3,enum=alphomega.github.SecretStore" json:"store,omitempty"`
 }
 
-func (x *CreatePackageVersionRequest) Reset() {
-	*x = CreatePackageVersionRequest{}
+func (x *RemoveSecretRepositoryRequest) Reset() {
+	*x = RemoveSecretRepositoryRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_github_github_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1327,13 +1444,13 @@ func (x *CreatePackageVersionRequest) Reset() {
 	}
 }
 
-func (x *CreatePackageVersionRequest) String() string {
+func (x *RemoveSecretRepositoryRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreatePackageVersionRequest) ProtoMessage() {}
+func (*RemoveSecretRepositoryRequest) ProtoMessage() {}
 
-func (x *CreatePackageVersionRequest) ProtoReflect() protoreflect.Message {
+func (x *RemoveSecretRepositoryRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_github_github_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1345,33 +1462,33 @@ func (x *CreatePackageVersionRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreatePackageVersionRequest.ProtoReflect.Descriptor instead.
-func (*CreatePackageVersionRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use RemoveSecretRepositoryRequest.ProtoReflect.Descriptor instead.
+func (*RemoveSecretRepositoryRequest) Descriptor() ([]byte, []int) {
 	return file_proto_github_github_proto_rawDescGZIP(), []int{23}
 }
 
-func (x *CreatePackageVersionRequest) GetName() string {
+func (x *RemoveSecretRepositoryRequest) GetName() string {
 	if x != nil {
 		return x.Name
 	}
 	return ""
 }
 
-func (x *CreatePackageVersionRequest) GetTag() string {
+func (x *RemoveSecretRepositoryRequest) GetRepository() string {
 	if x != nil {
-		return x.Tag
+		return x.Repository
 	}
 	return ""
 }
 
-func (x *CreatePackageVersionRequest) GetContent() []byte {
+func (x *RemoveSecretRepositoryRequest) GetStore() SecretStore {
 	if x != nil {
-		return x.Content
+		return x.Store
 	}
-	return nil
+	return SecretStore_ACTIONS
 }
 
-type CreatePackageVersionResponse struct {
+type RemoveSecretRepositoryResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
@@ -1379,8 +1496,8 @@ type CreatePackageVersionResponse struct {
 	Status int64 `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
 }
 
-func (x *CreatePackageVersionResponse) Reset() {
-	*x = CreatePackageVersionResponse{}
+func (x *RemoveSecretRepositoryResponse) Reset() {
+	*x = RemoveSecretRepositoryResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_github_github_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1388,13 +1505,13 @@ func (x *CreatePackageVersionResponse) Reset() {
 	}
 }
 
-func (x *CreatePackageVersionResponse) String() string {
+func (x *RemoveSecretRepositoryResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreatePackageVersionResponse) ProtoMessage() {}
+func (*RemoveSecretRepositoryResponse) ProtoMessage() {}
 
-func (x *CreatePackageVersionResponse) ProtoReflect() protoreflect.Message {
+func (x *RemoveSecretRepositoryResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_github_github_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1406,29 +1523,32 @@ func (x *CreatePackageVersionResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreatePackageVersionResponse.ProtoReflect.Descriptor instead.
-func (*CreatePackageVersionResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use RemoveSecretRepositoryResponse.ProtoReflect.Descriptor instead.
+func (*RemoveSecretRepositoryResponse) Descriptor() ([]byte, []int) {
 	return file_proto_github_github_proto_rawDescGZIP(), []int{24}
 }
 
-func (x *CreatePackageVersionResponse) GetStatus() int64 {
+func (x *RemoveSecretRepositoryResponse) GetStatus() int64 {
 	if x != nil {
 		return x.Status
 	}
 	return 0
 }
 
-type GetPackageFileRequest struct {
+type Variable struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Path string `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+	Name       string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Value      string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	CreatedAt  string `protobuf:"bytes,3,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	UpdatedAt  string `protobuf:"bytes,4,opt,name=updatedAt,proto3" json:"updatedAt,omitempty"`
+	Visibility string `protobuf:"bytes,5,opt,name=visibility,proto3" json:"visibility,omitempty"`
 }
 
-func (x *GetPackageFileRequest) Reset() {
-	*x = GetPackageFileRequest{}
+func (x *Variable) Reset() {
+	*x = Variable{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_github_github_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1436,13 +1556,13 @@ func (x *GetPackageFileRequest) Reset() {
 	}
 }
 
-func (x *GetPackageFileRequest) String() string {
+func (x *Variable) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetPackageFileRequest) ProtoMessage() {}
+func (*Variable) ProtoMessage() {}
 
-func (x *GetPackageFileRequest) ProtoReflect() protoreflect.Message {
+func (x *Variable) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_github_github_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1454,35 +1574,56 @@ func (x *GetPackageFileRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetPackageFileRequest.ProtoReflect.Descriptor instead.
-func (*GetPackageFileRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use Variable.ProtoReflect.Descriptor instead.
+func (*Variable) Descriptor() ([]byte, []int) {
 	return file_proto_github_github_proto_rawDescGZIP(), []int{25}
 }
 
-func (x *GetPackageFileRequest) GetName() string {
+func (x *Variable) GetName() string {
 	if x != nil {
 		return x.Name
 	}
 	return ""
 }
 
-func (x *GetPackageFileRequest) GetPath() string {
+func (x *Variable) GetValue() string {
 	if x != nil {
-		return x.Path
+		return x.Value
 	}
 	return ""
 }
 
-type GetPackageFileResponse struct {
+func (x *Variable) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *Variable) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+func (x *Variable) GetVisibility() string {
+	if x != nil {
+		return x.Visibility
+	}
+	return ""
+}
+
+type GetVariablesRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Content []byte `protobuf:"bytes,1,opt,name=content,proto3" json:"content,omitempty"`
+	Scope *SecretScope `protobuf:"bytes,1,opt,name=scope,proto3" json:"scope,omitempty"`
 }
 
-func (x *GetPackageFileResponse) Reset() {
-	*x = GetPackageFileResponse{}
+func (x *GetVariablesRequest) Reset() {
+	*x = GetVariablesRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_github_github_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1490,13 +1631,13 @@ func (x *GetPackageFileResponse) Reset() {
 	}
 }
 
-func (x *GetPackageFileResponse) String() string {
+func (x *GetVariablesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetPackageFileResponse) ProtoMessage() {}
+func (*GetVariablesRequest) ProtoMessage() {}
 
-func (x *GetPackageFileResponse) ProtoReflect() protoreflect.Message {
+func (x *GetVariablesRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_github_github_proto_msgTypes[26]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1508,28 +1649,28 @@ func (x *GetPackageFileResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetPackageFileResponse.ProtoReflect.Descriptor instead.
-func (*GetPackageFileResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetVariablesRequest.ProtoReflect.Descriptor instead.
+func (*GetVariablesRequest) Descriptor() ([]byte, []int) {
 	return file_proto_github_github_proto_rawDescGZIP(), []int{26}
 }
 
-func (x *GetPackageFileResponse) GetContent() []byte {
+func (x *GetVariablesRequest) GetScope() *SecretScope {
 	if x != nil {
-		return x.Content
+		return x.Scope
 	}
 	return nil
 }
 
-type GetPackageResponse struct {
+type GetVariablesResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Versions []*PackageVersion `protobuf:"bytes,1,rep,name=versions,proto3" json:"versions,omitempty"`
+	Variables []*Variable `protobuf:"bytes,1,rep,name=variables,proto3" json:"variables,omitempty"`
 }
 
-func (x *GetPackageResponse) Reset() {
-	*x = GetPackageResponse{}
+func (x *GetVariablesResponse) Reset() {
+	*x = GetVariablesResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_github_github_proto_msgTypes[27]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1537,13 +1678,13 @@ func (x *GetPackageResponse) Reset() {
 	}
 }
 
-func (x *GetPackageResponse) String() string {
+func (x *GetVariablesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetPackageResponse) ProtoMessage() {}
+func (*GetVariablesResponse) ProtoMessage() {}
 
-func (x *GetPackageResponse) ProtoReflect() protoreflect.Message {
+func (x *GetVariablesResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_github_github_proto_msgTypes[27]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1555,34 +1696,29 @@ func (x *GetPackageResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetPackageResponse.ProtoReflect.Descriptor instead.
-func (*GetPackageResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetVariablesResponse.ProtoReflect.Descriptor instead.
+func (*GetVariablesResponse) Descriptor() ([]byte, []int) {
 	return file_proto_github_github_proto_rawDescGZIP(), []int{27}
 }
 
-func (x *GetPackageResponse) GetVersions() []*PackageVersion {
+func (x *GetVariablesResponse) GetVariables() []*Variable {
 	if x != nil {
-		return x.Versions
+		return x.Variables
 	}
 	return nil
 }
 
-type PackageVersion struct {
+type GetVariableRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	RepoName    string  `protobuf:"bytes,1,opt,name=repoName,proto3" json:"repoName,omitempty"`
-	RepoPath    string  `protobuf:"bytes,2,opt,name=repoPath,proto3" json:"repoPath,omitempty"`
-	RepoSha     string  `protobuf:"bytes,3,opt,name=repoSha,proto3" json:"repoSha,omitempty"`
-	RepoLink    string  `protobuf:"bytes,4,opt,name=repoLink,proto3" json:"repoLink,omitempty"`
-	VersionId   *int64  `protobuf:"varint,5,opt,name=versionId,proto3,oneof" json:"versionId,omitempty"`
-	VersionSha  *string `protobuf:"bytes,6,opt,name=versionSha,proto3,oneof" json:"versionSha,omitempty"`
-	VersionLink *string `protobuf:"bytes,7,opt,name=versionLink,proto3,oneof" json:"versionLink,omitempty"`
+	Name  string       `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Scope *SecretScope `protobuf:"bytes,2,opt,name=scope,proto3" json:"scope,omitempty"`
 }
 
-func (x *PackageVersion) Reset() {
-	*x = PackageVersion{}
+func (x *GetVariableRequest) Reset() {
+	*x = GetVariableRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_github_github_proto_msgTypes[28]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1590,13 +1726,13 @@ func (x *PackageVersion) Reset() {
 	}
 }
 
-func (x *PackageVersion) String() string {
+func (x *GetVariableRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PackageVersion) ProtoMessage() {}
+func (*GetVariableRequest) ProtoMessage() {}
 
-func (x *PackageVersion) ProtoReflect() protoreflect.Message {
+func (x *GetVariableRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_github_github_proto_msgTypes[28]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1608,71 +1744,35 @@ func (x *PackageVersion) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PackageVersion.ProtoReflect.Descriptor instead.
-func (*PackageVersion) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetVariableRequest.ProtoReflect.Descriptor instead.
+func (*GetVariableRequest) Descriptor() ([]byte, []int) {
 	return file_proto_github_github_proto_rawDescGZIP(), []int{28}
 }
 
-func (x *PackageVersion) GetRepoName() string {
-	if x != nil {
-		return x.RepoName
-	}
-	return ""
-}
-
-func (x *PackageVersion) GetRepoPath() string {
-	if x != nil {
-		return x.RepoPath
-	}
-	return ""
-}
-
-func (x *PackageVersion) GetRepoSha() string {
+func (x *GetVariableRequest) GetName() string {
 	if x != nil {
-		return x.RepoSha
+		return x.Name
 	}
 	return ""
 }
 
-func (x *PackageVersion) GetRepoLink() string {
+func (x *GetVariableRequest) GetScope() *SecretScope {
 	if x != nil {
-		return x.RepoLink
-	}
-	return ""
-}
-
-func (x *PackageVersion) GetVersionId() int64 {
-	if x != nil && x.VersionId != nil {
-		return *x.VersionId
-	}
-	return 0
-}
-
-func (x *PackageVersion) GetVersionSha() string {
-	if x != nil && x.VersionSha != nil {
-		return *x.VersionSha
-	}
-	return ""
-}
-
-func (x *PackageVersion) GetVersionLink() string {
-	if x != nil && x.VersionLink != nil {
-		return *x.VersionLink
+		return x.Scope
 	}
-	return ""
+	return nil
 }
 
-type ContainerPackageRequest struct {
+type GetVariableResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id   int64  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Variable *Variable `protobuf:"bytes,1,opt,name=variable,proto3" json:"variable,omitempty"`
 }
 
-func (x *ContainerPackageRequest) Reset() {
-	*x = ContainerPackageRequest{}
+func (x *GetVariableResponse) Reset() {
+	*x = GetVariableResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_github_github_proto_msgTypes[29]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1680,13 +1780,13 @@ func (x *ContainerPackageRequest) Reset() {
 	}
 }
 
-func (x *ContainerPackageRequest) String() string {
+func (x *GetVariableResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ContainerPackageRequest) ProtoMessage() {}
+func (*GetVariableResponse) ProtoMessage() {}
 
-func (x *ContainerPackageRequest) ProtoReflect() protoreflect.Message {
+func (x *GetVariableResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_github_github_proto_msgTypes[29]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1698,36 +1798,32 @@ func (x *ContainerPackageRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ContainerPackageRequest.ProtoReflect.Descriptor instead.
-func (*ContainerPackageRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetVariableResponse.ProtoReflect.Descriptor instead.
+func (*GetVariableResponse) Descriptor() ([]byte, []int) {
 	return file_proto_github_github_proto_rawDescGZIP(), []int{29}
 }
 
-func (x *ContainerPackageRequest) GetId() int64 {
-	if x != nil {
-		return x.Id
-	}
-	return 0
-}
-
-func (x *ContainerPackageRequest) GetName() string {
+func (x *GetVariableResponse) GetVariable() *Variable {
 	if x != nil {
-		return x.Name
+		return x.Variable
 	}
-	return ""
+	return nil
 }
 
-type ContainerPackageResponse struct {
+type CreateVariableRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Status      int64 `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
-	ContainerId int64 `protobuf:"varint,2,opt,name=containerId,proto3" json:"containerId,omitempty"`
+	Name         string       `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Value        string       `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	Scope        *SecretScope `protobuf:"bytes,3,opt,name=scope,proto3" json:"scope,omitempty"`
+	Visibility   string       `protobuf:"bytes,4,opt,name=visibility,proto3" json:"visibility,omitempty"`
+	Repositories []string     `protobuf:"bytes,5,rep,name=repositories,proto3" json:"repositories,omitempty"`
 }
 
-func (x *ContainerPackageResponse) Reset() {
-	*x = ContainerPackageResponse{}
+func (x *CreateVariableRequest) Reset() {
+	*x = CreateVariableRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_github_github_proto_msgTypes[30]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1735,13 +1831,13 @@ func (x *ContainerPackageResponse) Reset() {
 	}
 }
 
-func (x *ContainerPackageResponse) String() string {
+func (x *CreateVariableRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ContainerPackageResponse) ProtoMessage() {}
+func (*CreateVariableRequest) ProtoMessage() {}
 
-func (x *ContainerPackageResponse) ProtoReflect() protoreflect.Message {
+func (x *CreateVariableRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_github_github_proto_msgTypes[30]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1753,37 +1849,56 @@ func (x *ContainerPackageResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ContainerPackageResponse.ProtoReflect.Descriptor instead.
-func (*ContainerPackageResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use CreateVariableRequest.ProtoReflect.Descriptor instead.
+func (*CreateVariableRequest) Descriptor() ([]byte, []int) {
 	return file_proto_github_github_proto_rawDescGZIP(), []int{30}
 }
 
-func (x *ContainerPackageResponse) GetStatus() int64 {
+func (x *CreateVariableRequest) GetName() string {
 	if x != nil {
-		return x.Status
+		return x.Name
 	}
-	return 0
+	return ""
 }
 
-func (x *ContainerPackageResponse) GetContainerId() int64 {
+func (x *CreateVariableRequest) GetValue() string {
 	if x != nil {
-		return x.ContainerId
+		return x.Value
 	}
-	return 0
+	return ""
 }
 
-type PushPackageRequest struct {
+func (x *CreateVariableRequest) GetScope() *SecretScope {
+	if x != nil {
+		return x.Scope
+	}
+	return nil
+}
+
+func (x *CreateVariableRequest) GetVisibility() string {
+	if x != nil {
+		return x.Visibility
+	}
+	return ""
+}
+
+func (x *CreateVariableRequest) GetRepositories() []string {
+	if x != nil {
+		return x.Repositories
+	}
+	return nil
+}
+
+type CreateVariableResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name       string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Tag        string `protobuf:"bytes,2,opt,name=tag,proto3" json:"tag,omitempty"`
-	VersionSHA string `protobuf:"bytes,3,opt,name=versionSHA,proto3" json:"versionSHA,omitempty"`
+	Status int64 `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
 }
 
-func (x *PushPackageRequest) Reset() {
-	*x = PushPackageRequest{}
+func (x *CreateVariableResponse) Reset() {
+	*x = CreateVariableResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_github_github_proto_msgTypes[31]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1791,13 +1906,13 @@ func (x *PushPackageRequest) Reset() {
 	}
 }
 
-func (x *PushPackageRequest) String() string {
+func (x *CreateVariableResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PushPackageRequest) ProtoMessage() {}
+func (*CreateVariableResponse) ProtoMessage() {}
 
-func (x *PushPackageRequest) ProtoReflect() protoreflect.Message {
+func (x *CreateVariableResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_github_github_proto_msgTypes[31]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1809,42 +1924,32 @@ func (x *PushPackageRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PushPackageRequest.ProtoReflect.Descriptor instead.
-func (*PushPackageRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use CreateVariableResponse.ProtoReflect.Descriptor instead.
+func (*CreateVariableResponse) Descriptor() ([]byte, []int) {
 	return file_proto_github_github_proto_rawDescGZIP(), []int{31}
 }
 
-func (x *PushPackageRequest) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
-}
-
-func (x *PushPackageRequest) GetTag() string {
-	if x != nil {
-		return x.Tag
-	}
-	return ""
-}
-
-func (x *PushPackageRequest) GetVersionSHA() string {
+func (x *CreateVariableResponse) GetStatus() int64 {
 	if x != nil {
-		return x.VersionSHA
+		return x.Status
 	}
-	return ""
+	return 0
 }
 
-type PushPackageResponse struct {
+type UpdateVariableRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Status int64 `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
+	Name         string       `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Value        string       `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	Scope        *SecretScope `protobuf:"bytes,3,opt,name=scope,proto3" json:"scope,omitempty"`
+	Visibility   string       `protobuf:"bytes,4,opt,name=visibility,proto3" json:"visibility,omitempty"`
+	Repositories []string     `protobuf:"bytes,5,rep,name=repositories,proto3" json:"repositories,omitempty"`
 }
 
-func (x *PushPackageResponse) Reset() {
-	*x = PushPackageResponse{}
+func (x *UpdateVariableRequest) Reset() {
+	*x = UpdateVariableRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_github_github_proto_msgTypes[32]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1852,13 +1957,13 @@ func (x *PushPackageResponse) Reset() {
 	}
 }
 
-func (x *PushPackageResponse) String() string {
+func (x *UpdateVariableRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PushPackageResponse) ProtoMessage() {}
+func (*UpdateVariableRequest) ProtoMessage() {}
 
-func (x *PushPackageResponse) ProtoReflect() protoreflect.Message {
+func (x *UpdateVariableRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_github_github_proto_msgTypes[32]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1870,28 +1975,56 @@ func (x *PushPackageResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PushPackageResponse.ProtoReflect.Descriptor instead.
-func (*PushPackageResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use UpdateVariableRequest.ProtoReflect.Descriptor instead.
+func (*UpdateVariableRequest) Descriptor() ([]byte, []int) {
 	return file_proto_github_github_proto_rawDescGZIP(), []int{32}
 }
 
-func (x *PushPackageResponse) GetStatus() int64 {
+func (x *UpdateVariableRequest) GetName() string {
 	if x != nil {
-		return x.Status
+		return x.Name
 	}
-	return 0
+	return ""
 }
 
-type CreatePackageRequest struct {
+func (x *UpdateVariableRequest) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *UpdateVariableRequest) GetScope() *SecretScope {
+	if x != nil {
+		return x.Scope
+	}
+	return nil
+}
+
+func (x *UpdateVariableRequest) GetVisibility() string {
+	if x != nil {
+		return x.Visibility
+	}
+	return ""
+}
+
+func (x *UpdateVariableRequest) GetRepositories() []string {
+	if x != nil {
+		return x.Repositories
+	}
+	return nil
+}
+
+type UpdateVariableResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Status int64 `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
 }
 
-func (x *CreatePackageRequest) Reset() {
-	*x = CreatePackageRequest{}
+func (x *UpdateVariableResponse) Reset() {
+	*x = UpdateVariableResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_github_github_proto_msgTypes[33]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1899,13 +2032,13 @@ func (x *CreatePackageRequest) Reset() {
 	}
 }
 
-func (x *CreatePackageRequest) String() string {
+func (x *UpdateVariableResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreatePackageRequest) ProtoMessage() {}
+func (*UpdateVariableResponse) ProtoMessage() {}
 
-func (x *CreatePackageRequest) ProtoReflect() protoreflect.Message {
+func (x *UpdateVariableResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_github_github_proto_msgTypes[33]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1917,28 +2050,29 @@ func (x *CreatePackageRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreatePackageRequest.ProtoReflect.Descriptor instead.
-func (*CreatePackageRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use UpdateVariableResponse.ProtoReflect.Descriptor instead.
+func (*UpdateVariableResponse) Descriptor() ([]byte, []int) {
 	return file_proto_github_github_proto_rawDescGZIP(), []int{33}
 }
 
-func (x *CreatePackageRequest) GetName() string {
+func (x *UpdateVariableResponse) GetStatus() int64 {
 	if x != nil {
-		return x.Name
+		return x.Status
 	}
-	return ""
+	return 0
 }
 
-type CreatePackageResponse struct {
+type DeleteVariableRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Status int64 `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
+	Name  string       `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Scope *SecretScope `protobuf:"bytes,2,opt,name=scope,proto3" json:"scope,omitempty"`
 }
 
-func (x *CreatePackageResponse) Reset() {
-	*x = CreatePackageResponse{}
+func (x *DeleteVariableRequest) Reset() {
+	*x = DeleteVariableRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_github_github_proto_msgTypes[34]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1946,13 +2080,13 @@ func (x *CreatePackageResponse) Reset() {
 	}
 }
 
-func (x *CreatePackageResponse) String() string {
+func (x *DeleteVariableRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreatePackageResponse) ProtoMessage() {}
+func (*DeleteVariableRequest) ProtoMessage() {}
 
-func (x *CreatePackageResponse) ProtoReflect() protoreflect.Message {
+func (x *DeleteVariableRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_github_github_proto_msgTypes[34]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1964,28 +2098,35 @@ func (x *CreatePackageResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreatePackageResponse.ProtoReflect.Descriptor instead.
-func (*CreatePackageResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use DeleteVariableRequest.ProtoReflect.Descriptor instead.
+func (*DeleteVariableRequest) Descriptor() ([]byte, []int) {
 	return file_proto_github_github_proto_rawDescGZIP(), []int{34}
 }
 
-func (x *CreatePackageResponse) GetStatus() int64 {
+func (x *DeleteVariableRequest) GetName() string {
 	if x != nil {
-		return x.Status
+		return x.Name
 	}
-	return 0
+	return ""
 }
 
-type GetPackageRequest struct {
+func (x *DeleteVariableRequest) GetScope() *SecretScope {
+	if x != nil {
+		return x.Scope
+	}
+	return nil
+}
+
+type DeleteVariableResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Status int64 `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
 }
 
-func (x *GetPackageRequest) Reset() {
-	*x = GetPackageRequest{}
+func (x *DeleteVariableResponse) Reset() {
+	*x = DeleteVariableResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_github_github_proto_msgTypes[35]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1993,13 +2134,13 @@ func (x *GetPackageRequest) Reset() {
 	}
 }
 
-func (x *GetPackageRequest) String() string {
+func (x *DeleteVariableResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetPackageRequest) ProtoMessage() {}
+func (*DeleteVariableResponse) ProtoMessage() {}
 
-func (x *GetPackageRequest) ProtoReflect() protoreflect.Message {
+func (x *DeleteVariableResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_github_github_proto_msgTypes[35]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2011,26 +2152,30 @@ func (x *GetPackageRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetPackageRequest.ProtoReflect.Descriptor instead.
-func (*GetPackageRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use DeleteVariableResponse.ProtoReflect.Descriptor instead.
+func (*DeleteVariableResponse) Descriptor() ([]byte, []int) {
 	return file_proto_github_github_proto_rawDescGZIP(), []int{35}
 }
 
-func (x *GetPackageRequest) GetName() string {
+func (x *DeleteVariableResponse) GetStatus() int64 {
 	if x != nil {
-		return x.Name
+		return x.Status
 	}
-	return ""
+	return 0
 }
 
-type GetPackagesRequest struct {
+type Package struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Name       string              `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Files      []*File             `protobuf:"bytes,2,rep,name=files,proto3" json:"files,omitempty"`
+	Containers []*docker.Container `protobuf:"bytes,3,rep,name=containers,proto3" json:"containers,omitempty"`
 }
 
-func (x *GetPackagesRequest) Reset() {
-	*x = GetPackagesRequest{}
+func (x *Package) Reset() {
+	*x = Package{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_github_github_proto_msgTypes[36]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2038,474 +2183,2452 @@ func (x *GetPackagesRequest) Reset() {
 	}
 }
 
-func (x *GetPackagesRequest) String() string {
+func (x *Package) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetPackagesRequest) ProtoMessage() {}
+func (*Package) ProtoMessage() {}
 
-func (x *GetPackagesRequest) ProtoReflect() protoreflect.Message {
+func (x *Package) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_github_github_proto_msgTypes[36]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
 		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use GetPackagesRequest.ProtoReflect.Descriptor instead.
-func (*GetPackagesRequest) Descriptor() ([]byte, []int) {
-	return file_proto_github_github_proto_rawDescGZIP(), []int{36}
-}
-
-type GetPackagesResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Packages []*SimplePackage `protobuf:"bytes,1,rep,name=packages,proto3" json:"packages,omitempty"`
-}
-
-func (x *GetPackagesResponse) Reset() {
-	*x = GetPackagesResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_github_github_proto_msgTypes[37]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
-}
-
-func (x *GetPackagesResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*GetPackagesResponse) ProtoMessage() {}
-
-func (x *GetPackagesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_github_github_proto_msgTypes[37]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Package.ProtoReflect.Descriptor instead.
+func (*Package) Descriptor() ([]byte, []int) {
+	return file_proto_github_github_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *Package) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Package) GetFiles() []*File {
+	if x != nil {
+		return x.Files
+	}
+	return nil
+}
+
+func (x *Package) GetContainers() []*docker.Container {
+	if x != nil {
+		return x.Containers
+	}
+	return nil
+}
+
+type SimplePackage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type        string `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	Size        int64  `protobuf:"varint,2,opt,name=size,proto3" json:"size,omitempty"`
+	Name        string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Path        string `protobuf:"bytes,4,opt,name=path,proto3" json:"path,omitempty"`
+	Sha         string `protobuf:"bytes,5,opt,name=sha,proto3" json:"sha,omitempty"`
+	GitUrl      string `protobuf:"bytes,6,opt,name=gitUrl,proto3" json:"gitUrl,omitempty"`
+	HtmlUrl     string `protobuf:"bytes,7,opt,name=htmlUrl,proto3" json:"htmlUrl,omitempty"`
+	DownloadUrl string `protobuf:"bytes,8,opt,name=downloadUrl,proto3" json:"downloadUrl,omitempty"`
+}
+
+func (x *SimplePackage) Reset() {
+	*x = SimplePackage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_github_github_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SimplePackage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SimplePackage) ProtoMessage() {}
+
+func (x *SimplePackage) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_github_github_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SimplePackage.ProtoReflect.Descriptor instead.
+func (*SimplePackage) Descriptor() ([]byte, []int) {
+	return file_proto_github_github_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *SimplePackage) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *SimplePackage) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *SimplePackage) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SimplePackage) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *SimplePackage) GetSha() string {
+	if x != nil {
+		return x.Sha
+	}
+	return ""
+}
+
+func (x *SimplePackage) GetGitUrl() string {
+	if x != nil {
+		return x.GitUrl
+	}
+	return ""
+}
+
+func (x *SimplePackage) GetHtmlUrl() string {
+	if x != nil {
+		return x.HtmlUrl
+	}
+	return ""
+}
+
+func (x *SimplePackage) GetDownloadUrl() string {
+	if x != nil {
+		return x.DownloadUrl
+	}
+	return ""
+}
+
+type GitPackage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id         int64  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name       string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Type       string `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
+	Version    int64  `protobuf:"varint,4,opt,name=version,proto3" json:"version,omitempty"`
+	Visibility string `protobuf:"bytes,5,opt,name=visibility,proto3" json:"visibility,omitempty"`
+	Url        string `protobuf:"bytes,6,opt,name=url,proto3" json:"url,omitempty"`
+	HtmlUrl    string `protobuf:"bytes,7,opt,name=htmlUrl,proto3" json:"htmlUrl,omitempty"`
+	OwnerId    int64  `protobuf:"varint,8,opt,name=ownerId,proto3" json:"ownerId,omitempty"`
+	OwnerName  string `protobuf:"bytes,9,opt,name=ownerName,proto3" json:"ownerName,omitempty"`
+	OwnerNode  string `protobuf:"bytes,10,opt,name=ownerNode,proto3" json:"ownerNode,omitempty"`
+	OwnerType  string `protobuf:"bytes,11,opt,name=ownerType,proto3" json:"ownerType,omitempty"`
+}
+
+func (x *GitPackage) Reset() {
+	*x = GitPackage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_github_github_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GitPackage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GitPackage) ProtoMessage() {}
+
+func (x *GitPackage) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_github_github_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GitPackage.ProtoReflect.Descriptor instead.
+func (*GitPackage) Descriptor() ([]byte, []int) {
+	return file_proto_github_github_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *GitPackage) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *GitPackage) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *GitPackage) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *GitPackage) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *GitPackage) GetVisibility() string {
+	if x != nil {
+		return x.Visibility
+	}
+	return ""
+}
+
+func (x *GitPackage) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *GitPackage) GetHtmlUrl() string {
+	if x != nil {
+		return x.HtmlUrl
+	}
+	return ""
+}
+
+func (x *GitPackage) GetOwnerId() int64 {
+	if x != nil {
+		return x.OwnerId
+	}
+	return 0
+}
+
+func (x *GitPackage) GetOwnerName() string {
+	if x != nil {
+		return x.OwnerName
+	}
+	return ""
+}
+
+func (x *GitPackage) GetOwnerNode() string {
+	if x != nil {
+		return x.OwnerNode
+	}
+	return ""
+}
+
+func (x *GitPackage) GetOwnerType() string {
+	if x != nil {
+		return x.OwnerType
+	}
+	return ""
+}
+
+type File struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name    string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Content []byte `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
+}
+
+func (x *File) Reset() {
+	*x = File{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_github_github_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *File) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*File) ProtoMessage() {}
+
+func (x *File) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_github_github_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use File.ProtoReflect.Descriptor instead.
+func (*File) Descriptor() ([]byte, []int) {
+	return file_proto_github_github_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *File) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *File) GetContent() []byte {
+	if x != nil {
+		return x.Content
+	}
+	return nil
+}
+
+type PackageIdentifier struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Tag  string `protobuf:"bytes,2,opt,name=tag,proto3" json:"tag,omitempty"`
+}
+
+func (x *PackageIdentifier) Reset() {
+	*x = PackageIdentifier{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_github_github_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PackageIdentifier) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PackageIdentifier) ProtoMessage() {}
+
+func (x *PackageIdentifier) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_github_github_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PackageIdentifier.ProtoReflect.Descriptor instead.
+func (*PackageIdentifier) Descriptor() ([]byte, []int) {
+	return file_proto_github_github_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *PackageIdentifier) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *PackageIdentifier) GetTag() string {
+	if x != nil {
+		return x.Tag
+	}
+	return ""
+}
+
+type GetPackageTagsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (x *GetPackageTagsRequest) Reset() {
+	*x = GetPackageTagsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_github_github_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetPackageTagsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPackageTagsRequest) ProtoMessage() {}
+
+func (x *GetPackageTagsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_github_github_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPackageTagsRequest.ProtoReflect.Descriptor instead.
+func (*GetPackageTagsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_github_github_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *GetPackageTagsRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type GetPackageTagsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Tags []string `protobuf:"bytes,1,rep,name=tags,proto3" json:"tags,omitempty"`
+}
+
+func (x *GetPackageTagsResponse) Reset() {
+	*x = GetPackageTagsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_github_github_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetPackageTagsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPackageTagsResponse) ProtoMessage() {}
+
+func (x *GetPackageTagsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_github_github_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPackageTagsResponse.ProtoReflect.Descriptor instead.
+func (*GetPackageTagsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_github_github_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *GetPackageTagsResponse) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+type PackageTag struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Tag  string `protobuf:"bytes,2,opt,name=tag,proto3" json:"tag,omitempty"`
+}
+
+func (x *PackageTag) Reset() {
+	*x = PackageTag{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_github_github_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PackageTag) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PackageTag) ProtoMessage() {}
+
+func (x *PackageTag) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_github_github_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PackageTag.ProtoReflect.Descriptor instead.
+func (*PackageTag) Descriptor() ([]byte, []int) {
+	return file_proto_github_github_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *PackageTag) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *PackageTag) GetTag() string {
+	if x != nil {
+		return x.Tag
+	}
+	return ""
+}
+
+type DeletePackageVersionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name    string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Tag     string `protobuf:"bytes,2,opt,name=tag,proto3" json:"tag,omitempty"`
+	Version *int64 `protobuf:"varint,3,opt,name=version,proto3,oneof" json:"version,omitempty"`
+}
+
+func (x *DeletePackageVersionRequest) Reset() {
+	*x = DeletePackageVersionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_github_github_proto_msgTypes[44]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeletePackageVersionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeletePackageVersionRequest) ProtoMessage() {}
+
+func (x *DeletePackageVersionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_github_github_proto_msgTypes[44]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeletePackageVersionRequest.ProtoReflect.Descriptor instead.
+func (*DeletePackageVersionRequest) Descriptor() ([]byte, []int) {
+	return file_proto_github_github_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *DeletePackageVersionRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *DeletePackageVersionRequest) GetTag() string {
+	if x != nil {
+		return x.Tag
+	}
+	return ""
+}
+
+func (x *DeletePackageVersionRequest) GetVersion() int64 {
+	if x != nil && x.Version != nil {
+		return *x.Version
+	}
+	return 0
+}
+
+type DeletePackageVersionResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status int64 `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
+}
+
+func (x *DeletePackageVersionResponse) Reset() {
+	*x = DeletePackageVersionResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_github_github_proto_msgTypes[45]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeletePackageVersionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeletePackageVersionResponse) ProtoMessage() {}
+
+func (x *DeletePackageVersionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_github_github_proto_msgTypes[45]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeletePackageVersionResponse.ProtoReflect.Descriptor instead.
+func (*DeletePackageVersionResponse) Descriptor() ([]byte, []int) {
+	return file_proto_github_github_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *DeletePackageVersionResponse) GetStatus() int64 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+type CreatePackageVersionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name    string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Tag     string `protobuf:"bytes,2,opt,name=tag,proto3" json:"tag,omitempty"`
+	Content []byte `protobuf:"bytes,3,opt,name=content,proto3" json:"content,omitempty"`
+}
+
+func (x *CreatePackageVersionRequest) Reset() {
+	*x = CreatePackageVersionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_github_github_proto_msgTypes[46]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreatePackageVersionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePackageVersionRequest) ProtoMessage() {}
+
+func (x *CreatePackageVersionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_github_github_proto_msgTypes[46]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePackageVersionRequest.ProtoReflect.Descriptor instead.
+func (*CreatePackageVersionRequest) Descriptor() ([]byte, []int) {
+	return file_proto_github_github_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *CreatePackageVersionRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreatePackageVersionRequest) GetTag() string {
+	if x != nil {
+		return x.Tag
+	}
+	return ""
+}
+
+func (x *CreatePackageVersionRequest) GetContent() []byte {
+	if x != nil {
+		return x.Content
+	}
+	return nil
+}
+
+type CreatePackageVersionResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status int64 `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
+}
+
+func (x *CreatePackageVersionResponse) Reset() {
+	*x = CreatePackageVersionResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_github_github_proto_msgTypes[47]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreatePackageVersionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePackageVersionResponse) ProtoMessage() {}
+
+func (x *CreatePackageVersionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_github_github_proto_msgTypes[47]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePackageVersionResponse.ProtoReflect.Descriptor instead.
+func (*CreatePackageVersionResponse) Descriptor() ([]byte, []int) {
+	return file_proto_github_github_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *CreatePackageVersionResponse) GetStatus() int64 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+type GetPackageFileRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Path string `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+}
+
+func (x *GetPackageFileRequest) Reset() {
+	*x = GetPackageFileRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_github_github_proto_msgTypes[48]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetPackageFileRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPackageFileRequest) ProtoMessage() {}
+
+func (x *GetPackageFileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_github_github_proto_msgTypes[48]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPackageFileRequest.ProtoReflect.Descriptor instead.
+func (*GetPackageFileRequest) Descriptor() ([]byte, []int) {
+	return file_proto_github_github_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *GetPackageFileRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *GetPackageFileRequest) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+type GetPackageFileResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Content []byte `protobuf:"bytes,1,opt,name=content,proto3" json:"content,omitempty"`
+}
+
+func (x *GetPackageFileResponse) Reset() {
+	*x = GetPackageFileResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_github_github_proto_msgTypes[49]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetPackageFileResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPackageFileResponse) ProtoMessage() {}
+
+func (x *GetPackageFileResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_github_github_proto_msgTypes[49]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPackageFileResponse.ProtoReflect.Descriptor instead.
+func (*GetPackageFileResponse) Descriptor() ([]byte, []int) {
+	return file_proto_github_github_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *GetPackageFileResponse) GetContent() []byte {
+	if x != nil {
+		return x.Content
+	}
+	return nil
+}
+
+type GetPackageResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Versions []*PackageVersion `protobuf:"bytes,1,rep,name=versions,proto3" json:"versions,omitempty"`
+}
+
+func (x *GetPackageResponse) Reset() {
+	*x = GetPackageResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_github_github_proto_msgTypes[50]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetPackageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPackageResponse) ProtoMessage() {}
+
+func (x *GetPackageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_github_github_proto_msgTypes[50]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPackageResponse.ProtoReflect.Descriptor instead.
+func (*GetPackageResponse) Descriptor() ([]byte, []int) {
+	return file_proto_github_github_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *GetPackageResponse) GetVersions() []*PackageVersion {
+	if x != nil {
+		return x.Versions
+	}
+	return nil
+}
+
+type PackageVersion struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RepoName    string  `protobuf:"bytes,1,opt,name=repoName,proto3" json:"repoName,omitempty"`
+	RepoPath    string  `protobuf:"bytes,2,opt,name=repoPath,proto3" json:"repoPath,omitempty"`
+	RepoSha     string  `protobuf:"bytes,3,opt,name=repoSha,proto3" json:"repoSha,omitempty"`
+	RepoLink    string  `protobuf:"bytes,4,opt,name=repoLink,proto3" json:"repoLink,omitempty"`
+	VersionId   *int64  `protobuf:"varint,5,opt,name=versionId,proto3,oneof" json:"versionId,omitempty"`
+	VersionSha  *string `protobuf:"bytes,6,opt,name=versionSha,proto3,oneof" json:"versionSha,omitempty"`
+	VersionLink *string `protobuf:"bytes,7,opt,name=versionLink,proto3,oneof" json:"versionLink,omitempty"`
+}
+
+func (x *PackageVersion) Reset() {
+	*x = PackageVersion{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_github_github_proto_msgTypes[51]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PackageVersion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PackageVersion) ProtoMessage() {}
+
+func (x *PackageVersion) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_github_github_proto_msgTypes[51]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PackageVersion.ProtoReflect.Descriptor instead.
+func (*PackageVersion) Descriptor() ([]byte, []int) {
+	return file_proto_github_github_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *PackageVersion) GetRepoName() string {
+	if x != nil {
+		return x.RepoName
+	}
+	return ""
+}
+
+func (x *PackageVersion) GetRepoPath() string {
+	if x != nil {
+		return x.RepoPath
+	}
+	return ""
+}
+
+func (x *PackageVersion) GetRepoSha() string {
+	if x != nil {
+		return x.RepoSha
+	}
+	return ""
+}
+
+func (x *PackageVersion) GetRepoLink() string {
+	if x != nil {
+		return x.RepoLink
+	}
+	return ""
+}
+
+func (x *PackageVersion) GetVersionId() int64 {
+	if x != nil && x.VersionId != nil {
+		return *x.VersionId
+	}
+	return 0
+}
+
+func (x *PackageVersion) GetVersionSha() string {
+	if x != nil && x.VersionSha != nil {
+		return *x.VersionSha
+	}
+	return ""
+}
+
+func (x *PackageVersion) GetVersionLink() string {
+	if x != nil && x.VersionLink != nil {
+		return *x.VersionLink
+	}
+	return ""
+}
+
+type ContainerPackageRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id   int64  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (x *ContainerPackageRequest) Reset() {
+	*x = ContainerPackageRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_github_github_proto_msgTypes[52]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ContainerPackageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ContainerPackageRequest) ProtoMessage() {}
+
+func (x *ContainerPackageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_github_github_proto_msgTypes[52]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ContainerPackageRequest.ProtoReflect.Descriptor instead.
+func (*ContainerPackageRequest) Descriptor() ([]byte, []int) {
+	return file_proto_github_github_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *ContainerPackageRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ContainerPackageRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type ContainerPackageResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status      int64 `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
+	ContainerId int64 `protobuf:"varint,2,opt,name=containerId,proto3" json:"containerId,omitempty"`
+}
+
+func (x *ContainerPackageResponse) Reset() {
+	*x = ContainerPackageResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_github_github_proto_msgTypes[53]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ContainerPackageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ContainerPackageResponse) ProtoMessage() {}
+
+func (x *ContainerPackageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_github_github_proto_msgTypes[53]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ContainerPackageResponse.ProtoReflect.Descriptor instead.
+func (*ContainerPackageResponse) Descriptor() ([]byte, []int) {
+	return file_proto_github_github_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *ContainerPackageResponse) GetStatus() int64 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+func (x *ContainerPackageResponse) GetContainerId() int64 {
+	if x != nil {
+		return x.ContainerId
+	}
+	return 0
+}
+
+type PushPackageRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name       string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Tag        string `protobuf:"bytes,2,opt,name=tag,proto3" json:"tag,omitempty"`
+	VersionSHA string `protobuf:"bytes,3,opt,name=versionSHA,proto3" json:"versionSHA,omitempty"`
+	Endpoint   string `protobuf:"bytes,4,opt,name=endpoint,proto3" json:"endpoint,omitempty"`
+}
+
+func (x *PushPackageRequest) Reset() {
+	*x = PushPackageRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_github_github_proto_msgTypes[54]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PushPackageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PushPackageRequest) ProtoMessage() {}
+
+func (x *PushPackageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_github_github_proto_msgTypes[54]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PushPackageRequest.ProtoReflect.Descriptor instead.
+func (*PushPackageRequest) Descriptor() ([]byte, []int) {
+	return file_proto_github_github_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *PushPackageRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *PushPackageRequest) GetTag() string {
+	if x != nil {
+		return x.Tag
+	}
+	return ""
+}
+
+func (x *PushPackageRequest) GetVersionSHA() string {
+	if x != nil {
+		return x.VersionSHA
+	}
+	return ""
+}
+
+func (x *PushPackageRequest) GetEndpoint() string {
+	if x != nil {
+		return x.Endpoint
+	}
+	return ""
+}
+
+type PushPackageResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status int64 `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
+	// the push runs in a job, see the job push result
+	//
+	// Deprecated: Do not use.
+	Digest string `protobuf:"bytes,2,opt,name=digest,proto3" json:"digest,omitempty"`
+	// Deprecated: Do not use.
+	VersionId int64  `protobuf:"varint,3,opt,name=versionId,proto3" json:"versionId,omitempty"`
+	JobId     string `protobuf:"bytes,4,opt,name=jobId,proto3" json:"jobId,omitempty"`
+}
+
+func (x *PushPackageResponse) Reset() {
+	*x = PushPackageResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_github_github_proto_msgTypes[55]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PushPackageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PushPackageResponse) ProtoMessage() {}
+
+func (x *PushPackageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_github_github_proto_msgTypes[55]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PushPackageResponse.ProtoReflect.Descriptor instead.
+func (*PushPackageResponse) Descriptor() ([]byte, []int) {
+	return file_proto_github_github_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *PushPackageResponse) GetStatus() int64 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+// Deprecated: Do not use.
+func (x *PushPackageResponse) GetDigest() string {
+	if x != nil {
+		return x.Digest
+	}
+	return ""
+}
+
+// Deprecated: Do not use.
+func (x *PushPackageResponse) GetVersionId() int64 {
+	if x != nil {
+		return x.VersionId
+	}
+	return 0
+}
+
+func (x *PushPackageResponse) GetJobId() string {
+	if x != nil {
+		return x.JobId
+	}
+	return ""
+}
+
+type CreatePackageRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (x *CreatePackageRequest) Reset() {
+	*x = CreatePackageRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_github_github_proto_msgTypes[56]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreatePackageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePackageRequest) ProtoMessage() {}
+
+func (x *CreatePackageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_github_github_proto_msgTypes[56]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePackageRequest.ProtoReflect.Descriptor instead.
+func (*CreatePackageRequest) Descriptor() ([]byte, []int) {
+	return file_proto_github_github_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *CreatePackageRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type CreatePackageResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status int64 `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
+}
+
+func (x *CreatePackageResponse) Reset() {
+	*x = CreatePackageResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_github_github_proto_msgTypes[57]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreatePackageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePackageResponse) ProtoMessage() {}
+
+func (x *CreatePackageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_github_github_proto_msgTypes[57]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePackageResponse.ProtoReflect.Descriptor instead.
+func (*CreatePackageResponse) Descriptor() ([]byte, []int) {
+	return file_proto_github_github_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *CreatePackageResponse) GetStatus() int64 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+type GetPackageRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (x *GetPackageRequest) Reset() {
+	*x = GetPackageRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_github_github_proto_msgTypes[58]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetPackageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPackageRequest) ProtoMessage() {}
+
+func (x *GetPackageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_github_github_proto_msgTypes[58]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPackageRequest.ProtoReflect.Descriptor instead.
+func (*GetPackageRequest) Descriptor() ([]byte, []int) {
+	return file_proto_github_github_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *GetPackageRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type GetPackagesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *GetPackagesRequest) Reset() {
+	*x = GetPackagesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_github_github_proto_msgTypes[59]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetPackagesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPackagesRequest) ProtoMessage() {}
+
+func (x *GetPackagesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_github_github_proto_msgTypes[59]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPackagesRequest.ProtoReflect.Descriptor instead.
+func (*GetPackagesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_github_github_proto_rawDescGZIP(), []int{59}
+}
+
+type GetPackagesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Packages []*SimplePackage `protobuf:"bytes,1,rep,name=packages,proto3" json:"packages,omitempty"`
+}
+
+func (x *GetPackagesResponse) Reset() {
+	*x = GetPackagesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_github_github_proto_msgTypes[60]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetPackagesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPackagesResponse) ProtoMessage() {}
+
+func (x *GetPackagesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_github_github_proto_msgTypes[60]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPackagesResponse.ProtoReflect.Descriptor instead.
+func (*GetPackagesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_github_github_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *GetPackagesResponse) GetPackages() []*SimplePackage {
+	if x != nil {
+		return x.Packages
+	}
+	return nil
+}
+
+var File_proto_github_github_proto protoreflect.FileDescriptor
+
+var file_proto_github_github_proto_rawDesc = []byte{
+	0x0a, 0x19, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2f, 0x67,
+	0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x10, 0x61, 0x6c, 0x70,
+	0x68, 0x6f, 0x6d, 0x65, 0x67, 0x61, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x1a, 0x19, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x64, 0x6f, 0x63, 0x6b, 0x65, 0x72, 0x2f, 0x64, 0x6f, 0x63, 0x6b,
+	0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x2a, 0x0a, 0x14, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x22, 0x45, 0x0a, 0x15, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x61,
+	0x63, 0x6b, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a,
+	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x6a, 0x6f, 0x62, 0x49, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6a, 0x6f, 0x62, 0x49, 0x64, 0x22, 0x62, 0x0a, 0x17, 0x47,
+	0x65, 0x74, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x33, 0x0a, 0x05, 0x73, 0x63,
+	0x6f, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x61, 0x6c, 0x70, 0x68,
+	0x6f, 0x6d, 0x65, 0x67, 0x61, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x53, 0x65, 0x63,
+	0x72, 0x65, 0x74, 0x53, 0x63, 0x6f, 0x70, 0x65, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x22,
+	0x34, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x74,
+	0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x63,
+	0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x63, 0x6f,
+	0x6e, 0x74, 0x65, 0x6e, 0x74, 0x22, 0x38, 0x0a, 0x16, 0x53, 0x79, 0x6e, 0x63, 0x45, 0x6e, 0x76,
+	0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x1e, 0x0a, 0x0a, 0x72, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0a, 0x72, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x22,
+	0x31, 0x0a, 0x17, 0x53, 0x79, 0x6e, 0x63, 0x45, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65,
+	0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x22, 0x5e, 0x0a, 0x13, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x65, 0x63, 0x72,
+	0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x33, 0x0a,
+	0x05, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x61,
+	0x6c, 0x70, 0x68, 0x6f, 0x6d, 0x65, 0x67, 0x61, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
+	0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x53, 0x63, 0x6f, 0x70, 0x65, 0x52, 0x05, 0x73, 0x63, 0x6f,
+	0x70, 0x65, 0x22, 0x2e, 0x0a, 0x14, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x65, 0x63, 0x72,
+	0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x22, 0xbc, 0x01, 0x0a, 0x13, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x65, 0x63,
+	0x72, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x18,
+	0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x33, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x70,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x61, 0x6c, 0x70, 0x68, 0x6f, 0x6d,
+	0x65, 0x67, 0x61, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x53, 0x65, 0x63, 0x72, 0x65,
+	0x74, 0x53, 0x63, 0x6f, 0x70, 0x65, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x12, 0x1e, 0x0a,
+	0x0a, 0x76, 0x69, 0x73, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0a, 0x76, 0x69, 0x73, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x12, 0x22, 0x0a,
+	0x0c, 0x72, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x18, 0x05, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x0c, 0x72, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x69, 0x65,
+	0x73, 0x22, 0x2e, 0x0a, 0x14, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x65, 0x63, 0x72, 0x65,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x22, 0x48, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x33, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x61, 0x6c, 0x70, 0x68, 0x6f, 0x6d, 0x65, 0x67,
+	0x61, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x53,
+	0x63, 0x6f, 0x70, 0x65, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x22, 0x48, 0x0a, 0x12, 0x47,
+	0x65, 0x74, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x32, 0x0a, 0x07, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x18, 0x2e, 0x61, 0x6c, 0x70, 0x68, 0x6f, 0x6d, 0x65, 0x67, 0x61, 0x2e, 0x67,
+	0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x52, 0x07, 0x73, 0x65,
+	0x63, 0x72, 0x65, 0x74, 0x73, 0x22, 0x9c, 0x01, 0x0a, 0x06, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74,
+	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64,
+	0x41, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74,
+	0x12, 0x1e, 0x0a, 0x0a, 0x76, 0x69, 0x73, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x76, 0x69, 0x73, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79,
+	0x12, 0x22, 0x0a, 0x0c, 0x72, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x69, 0x65, 0x73,
+	0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0c, 0x72, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f,
+	0x72, 0x69, 0x65, 0x73, 0x22, 0xb5, 0x01, 0x0a, 0x0b, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x53,
+	0x63, 0x6f, 0x70, 0x65, 0x12, 0x2f, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x1b, 0x2e, 0x61, 0x6c, 0x70, 0x68, 0x6f, 0x6d, 0x65, 0x67, 0x61, 0x2e, 0x67,
+	0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x53, 0x63, 0x6f, 0x70, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52,
+	0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x72, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74,
+	0x6f, 0x72, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x72, 0x65, 0x70, 0x6f, 0x73,
+	0x69, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x20, 0x0a, 0x0b, 0x65, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e,
+	0x6d, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x65, 0x6e, 0x76, 0x69,
+	0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x33, 0x0a, 0x05, 0x73, 0x74, 0x6f, 0x72, 0x65,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1d, 0x2e, 0x61, 0x6c, 0x70, 0x68, 0x6f, 0x6d, 0x65,
+	0x67, 0x61, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74,
+	0x53, 0x74, 0x6f, 0x72, 0x65, 0x52, 0x05, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x22, 0x84, 0x01, 0x0a,
+	0x17, 0x47, 0x65, 0x74, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72,
+	0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b,
+	0x77, 0x69, 0x74, 0x68, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x0b, 0x77, 0x69, 0x74, 0x68, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x33,
+	0x0a, 0x05, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e,
+	0x61, 0x6c, 0x70, 0x68, 0x6f, 0x6d, 0x65, 0x67, 0x61, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
+	0x2e, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x53, 0x63, 0x6f, 0x70, 0x65, 0x52, 0x05, 0x73, 0x63,
+	0x6f, 0x70, 0x65, 0x22, 0x57, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74,
+	0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x3b, 0x0a, 0x08, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x1f, 0x2e, 0x61, 0x6c, 0x70, 0x68, 0x6f, 0x6d, 0x65, 0x67, 0x61, 0x2e, 0x67, 0x69,
+	0x74, 0x68, 0x75, 0x62, 0x2e, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x52, 0x08, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0xe1, 0x01, 0x0a,
+	0x0d, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x18,
+	0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x26, 0x0a, 0x0e, 0x72, 0x6f,
+	0x6c, 0x6c, 0x65, 0x64, 0x42, 0x61, 0x63, 0x6b, 0x46, 0x72, 0x6f, 0x6d, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0e, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x64, 0x42, 0x61, 0x63, 0x6b, 0x46, 0x72,
+	0x6f, 0x6d, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x12, 0x1e, 0x0a, 0x0a,
+	0x76, 0x69, 0x73, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0a, 0x76, 0x69, 0x73, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x12, 0x22, 0x0a, 0x0c,
+	0x72, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x18, 0x07, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x0c, 0x72, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x69, 0x65, 0x73,
+	0x22, 0x7a, 0x0a, 0x15, 0x52, 0x6f, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x53, 0x65, 0x63, 0x72,
+	0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a,
+	0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07,
+	0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x33, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x70, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x61, 0x6c, 0x70, 0x68, 0x6f, 0x6d, 0x65,
+	0x67, 0x61, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74,
+	0x53, 0x63, 0x6f, 0x70, 0x65, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x22, 0x4a, 0x0a, 0x16,
+	0x52, 0x6f, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x18,
+	0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x67, 0x0a, 0x1c, 0x47, 0x65, 0x74, 0x53,
+	0x65, 0x63, 0x72, 0x65, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x69, 0x65,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x33, 0x0a, 0x05,
+	0x73, 0x74, 0x6f, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1d, 0x2e, 0x61, 0x6c,
+	0x70, 0x68, 0x6f, 0x6d, 0x65, 0x67, 0x61, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x53,
+	0x65, 0x63, 0x72, 0x65, 0x74, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x52, 0x05, 0x73, 0x74, 0x6f, 0x72,
+	0x65, 0x22, 0x43, 0x0a, 0x1d, 0x47, 0x65, 0x74, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x52, 0x65,
+	0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x72, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x69,
+	0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0c, 0x72, 0x65, 0x70, 0x6f, 0x73, 0x69,
+	0x74, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x22, 0x85, 0x01, 0x0a, 0x1a, 0x41, 0x64, 0x64, 0x53, 0x65,
+	0x63, 0x72, 0x65, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x72, 0x65, 0x70,
+	0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x72,
+	0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x33, 0x0a, 0x05, 0x73, 0x74, 0x6f,
+	0x72, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1d, 0x2e, 0x61, 0x6c, 0x70, 0x68, 0x6f,
+	0x6d, 0x65, 0x67, 0x61, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x53, 0x65, 0x63, 0x72,
+	0x65, 0x74, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x52, 0x05, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x22, 0x35,
+	0x0a, 0x1b, 0x41, 0x64, 0x64, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x73,
+	0x69, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a,
+	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x88, 0x01, 0x0a, 0x1d, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65,
+	0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x79,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x72,
+	0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0a, 0x72, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x33, 0x0a, 0x05, 0x73,
+	0x74, 0x6f, 0x72, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1d, 0x2e, 0x61, 0x6c, 0x70,
+	0x68, 0x6f, 0x6d, 0x65, 0x67, 0x61, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x53, 0x65,
+	0x63, 0x72, 0x65, 0x74, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x52, 0x05, 0x73, 0x74, 0x6f, 0x72, 0x65,
+	0x22, 0x38, 0x0a, 0x1e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74,
+	0x52, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x90, 0x01, 0x0a, 0x08, 0x56,
+	0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12,
+	0x1c, 0x0a, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1e, 0x0a,
+	0x0a, 0x76, 0x69, 0x73, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0a, 0x76, 0x69, 0x73, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x22, 0x4a, 0x0a,
+	0x13, 0x47, 0x65, 0x74, 0x56, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x33, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x61, 0x6c, 0x70, 0x68, 0x6f, 0x6d, 0x65, 0x67, 0x61, 0x2e,
+	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x53, 0x63, 0x6f,
+	0x70, 0x65, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x22, 0x50, 0x0a, 0x14, 0x47, 0x65, 0x74,
+	0x56, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x38, 0x0a, 0x09, 0x76, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x61, 0x6c, 0x70, 0x68, 0x6f, 0x6d, 0x65, 0x67, 0x61,
+	0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x56, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65,
+	0x52, 0x09, 0x76, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x22, 0x5d, 0x0a, 0x12, 0x47,
+	0x65, 0x74, 0x56, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x33, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x61, 0x6c, 0x70, 0x68, 0x6f, 0x6d, 0x65, 0x67, 0x61,
+	0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x53, 0x63,
+	0x6f, 0x70, 0x65, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x22, 0x4d, 0x0a, 0x13, 0x47, 0x65,
+	0x74, 0x56, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x36, 0x0a, 0x08, 0x76, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x61, 0x6c, 0x70, 0x68, 0x6f, 0x6d, 0x65, 0x67, 0x61, 0x2e,
+	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x56, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x52,
+	0x08, 0x76, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x22, 0xba, 0x01, 0x0a, 0x15, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x56, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x33, 0x0a,
+	0x05, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x61,
+	0x6c, 0x70, 0x68, 0x6f, 0x6d, 0x65, 0x67, 0x61, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
+	0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x53, 0x63, 0x6f, 0x70, 0x65, 0x52, 0x05, 0x73, 0x63, 0x6f,
+	0x70, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x76, 0x69, 0x73, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x76, 0x69, 0x73, 0x69, 0x62, 0x69, 0x6c, 0x69,
+	0x74, 0x79, 0x12, 0x22, 0x0a, 0x0c, 0x72, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x69,
+	0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0c, 0x72, 0x65, 0x70, 0x6f, 0x73, 0x69,
+	0x74, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x22, 0x30, 0x0a, 0x16, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x56, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0xba, 0x01, 0x0a, 0x15, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x56, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x33, 0x0a, 0x05,
+	0x73, 0x63, 0x6f, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x61, 0x6c,
+	0x70, 0x68, 0x6f, 0x6d, 0x65, 0x67, 0x61, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x53,
+	0x65, 0x63, 0x72, 0x65, 0x74, 0x53, 0x63, 0x6f, 0x70, 0x65, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x70,
+	0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x76, 0x69, 0x73, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x76, 0x69, 0x73, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74,
+	0x79, 0x12, 0x22, 0x0a, 0x0c, 0x72, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x69, 0x65,
+	0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0c, 0x72, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74,
+	0x6f, 0x72, 0x69, 0x65, 0x73, 0x22, 0x30, 0x0a, 0x16, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x56,
+	0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x60, 0x0a, 0x15, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x56, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x33, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x61, 0x6c, 0x70, 0x68, 0x6f, 0x6d, 0x65, 0x67, 0x61, 0x2e,
+	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x53, 0x63, 0x6f,
+	0x70, 0x65, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x22, 0x30, 0x0a, 0x16, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x56, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x88, 0x01, 0x0a, 0x07,
+	0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x2c, 0x0a, 0x05, 0x66,
+	0x69, 0x6c, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x61, 0x6c, 0x70,
+	0x68, 0x6f, 0x6d, 0x65, 0x67, 0x61, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x46, 0x69,
+	0x6c, 0x65, 0x52, 0x05, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x12, 0x3b, 0x0a, 0x0a, 0x63, 0x6f, 0x6e,
+	0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e,
+	0x61, 0x6c, 0x70, 0x68, 0x6f, 0x6d, 0x65, 0x67, 0x61, 0x2e, 0x64, 0x6f, 0x63, 0x6b, 0x65, 0x72,
+	0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x52, 0x0a, 0x63, 0x6f, 0x6e, 0x74,
+	0x61, 0x69, 0x6e, 0x65, 0x72, 0x73, 0x22, 0xc5, 0x01, 0x0a, 0x0d, 0x53, 0x69, 0x6d, 0x70, 0x6c,
+	0x65, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x12, 0x0a, 0x04,
+	0x73, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65,
+	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x68, 0x61, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x73, 0x68, 0x61, 0x12, 0x16, 0x0a, 0x06, 0x67, 0x69,
+	0x74, 0x55, 0x72, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x67, 0x69, 0x74, 0x55,
+	0x72, 0x6c, 0x12, 0x18, 0x0a, 0x07, 0x68, 0x74, 0x6d, 0x6c, 0x55, 0x72, 0x6c, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x68, 0x74, 0x6d, 0x6c, 0x55, 0x72, 0x6c, 0x12, 0x20, 0x0a, 0x0b,
+	0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x55, 0x72, 0x6c, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0b, 0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x55, 0x72, 0x6c, 0x22, 0x9e,
+	0x02, 0x0a, 0x0a, 0x47, 0x69, 0x74, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12,
+	0x1e, 0x0a, 0x0a, 0x76, 0x69, 0x73, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0a, 0x76, 0x69, 0x73, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x12,
+	0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72,
+	0x6c, 0x12, 0x18, 0x0a, 0x07, 0x68, 0x74, 0x6d, 0x6c, 0x55, 0x72, 0x6c, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x68, 0x74, 0x6d, 0x6c, 0x55, 0x72, 0x6c, 0x12, 0x18, 0x0a, 0x07, 0x6f,
+	0x77, 0x6e, 0x65, 0x72, 0x49, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x6f, 0x77,
+	0x6e, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x4e, 0x61,
+	0x6d, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x4e,
+	0x61, 0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x4e, 0x6f, 0x64, 0x65,
+	0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x4e, 0x6f, 0x64,
+	0x65, 0x12, 0x1c, 0x0a, 0x09, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x18, 0x0b,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x22,
+	0x34, 0x0a, 0x04, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x63,
+	0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x63, 0x6f,
+	0x6e, 0x74, 0x65, 0x6e, 0x74, 0x22, 0x39, 0x0a, 0x11, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65,
+	0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x10,
+	0x0a, 0x03, 0x74, 0x61, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x74, 0x61, 0x67,
+	0x22, 0x2b, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x54, 0x61,
+	0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x2c, 0x0a,
+	0x16, 0x47, 0x65, 0x74, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x54, 0x61, 0x67, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x61, 0x67, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x74, 0x61, 0x67, 0x73, 0x22, 0x32, 0x0a, 0x0a, 0x50,
+	0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x54, 0x61, 0x67, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x10, 0x0a,
+	0x03, 0x74, 0x61, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x74, 0x61, 0x67, 0x22,
+	0x6e, 0x0a, 0x1b, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65,
+	0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12,
+	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x74, 0x61, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x74, 0x61, 0x67, 0x12, 0x1d, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x03, 0x48, 0x00, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x88, 0x01, 0x01, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22,
+	0x36, 0x0a, 0x1c, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65,
+	0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x5d, 0x0a, 0x1b, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x74, 0x61,
+	0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x74, 0x61, 0x67, 0x12, 0x18, 0x0a, 0x07,
+	0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x63,
+	0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x22, 0x36, 0x0a, 0x1c, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x3f,
+	0x0a, 0x15, 0x47, 0x65, 0x74, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x46, 0x69, 0x6c, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x70,
+	0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x22,
+	0x32, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x46, 0x69, 0x6c,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e,
+	0x74, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74,
+	0x65, 0x6e, 0x74, 0x22, 0x52, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3c, 0x0a, 0x08, 0x76, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x61, 0x6c,
+	0x70, 0x68, 0x6f, 0x6d, 0x65, 0x67, 0x61, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x50,
+	0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x76,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x9a, 0x02, 0x0a, 0x0e, 0x50, 0x61, 0x63, 0x6b,
+	0x61, 0x67, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65,
+	0x70, 0x6f, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65,
+	0x70, 0x6f, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x70, 0x6f, 0x50, 0x61,
+	0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x70, 0x6f, 0x50, 0x61,
+	0x74, 0x68, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x70, 0x6f, 0x53, 0x68, 0x61, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x72, 0x65, 0x70, 0x6f, 0x53, 0x68, 0x61, 0x12, 0x1a, 0x0a, 0x08,
+	0x72, 0x65, 0x70, 0x6f, 0x4c, 0x69, 0x6e, 0x6b, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x72, 0x65, 0x70, 0x6f, 0x4c, 0x69, 0x6e, 0x6b, 0x12, 0x21, 0x0a, 0x09, 0x76, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x49, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x48, 0x00, 0x52, 0x09, 0x76,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x88, 0x01, 0x01, 0x12, 0x23, 0x0a, 0x0a, 0x76,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x53, 0x68, 0x61, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x48,
+	0x01, 0x52, 0x0a, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x53, 0x68, 0x61, 0x88, 0x01, 0x01,
+	0x12, 0x25, 0x0a, 0x0b, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x6e, 0x6b, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x09, 0x48, 0x02, 0x52, 0x0b, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x4c, 0x69, 0x6e, 0x6b, 0x88, 0x01, 0x01, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x76, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x49, 0x64, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x53, 0x68, 0x61, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x4c, 0x69, 0x6e, 0x6b, 0x22, 0x3d, 0x0a, 0x17, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65,
+	0x72, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12,
+	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x22, 0x54, 0x0a, 0x18, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72,
+	0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x61,
+	0x69, 0x6e, 0x65, 0x72, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x63, 0x6f,
+	0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x49, 0x64, 0x22, 0x76, 0x0a, 0x12, 0x50, 0x75, 0x73,
+	0x68, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x74, 0x61, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x74, 0x61, 0x67, 0x12, 0x1e, 0x0a, 0x0a, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x53, 0x48, 0x41, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x76, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x53, 0x48, 0x41, 0x12, 0x1a, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e,
+	0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e,
+	0x74, 0x22, 0x81, 0x01, 0x0a, 0x13, 0x50, 0x75, 0x73, 0x68, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x12, 0x1a, 0x0a, 0x06, 0x64, 0x69, 0x67, 0x65, 0x73, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x02, 0x18, 0x01, 0x52, 0x06, 0x64, 0x69, 0x67, 0x65, 0x73, 0x74, 0x12, 0x20, 0x0a,
+	0x09, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03,
+	0x42, 0x02, 0x18, 0x01, 0x52, 0x09, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12,
+	0x14, 0x0a, 0x05, 0x6a, 0x6f, 0x62, 0x49, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x6a, 0x6f, 0x62, 0x49, 0x64, 0x22, 0x2a, 0x0a, 0x14, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50,
+	0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x22, 0x2f, 0x0a, 0x15, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x61, 0x63, 0x6b, 0x61,
+	0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x22, 0x27, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x14, 0x0a, 0x12, 0x47,
+	0x65, 0x74, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x22, 0x52, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3b, 0x0a, 0x08, 0x70, 0x61, 0x63, 0x6b,
+	0x61, 0x67, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x61, 0x6c, 0x70,
+	0x68, 0x6f, 0x6d, 0x65, 0x67, 0x61, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x53, 0x69,
+	0x6d, 0x70, 0x6c, 0x65, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x52, 0x08, 0x70, 0x61, 0x63,
+	0x6b, 0x61, 0x67, 0x65, 0x73, 0x2a, 0x3a, 0x0a, 0x0b, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x53,
+	0x74, 0x6f, 0x72, 0x65, 0x12, 0x0b, 0x0a, 0x07, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x53, 0x10,
+	0x00, 0x12, 0x0e, 0x0a, 0x0a, 0x44, 0x45, 0x50, 0x45, 0x4e, 0x44, 0x41, 0x42, 0x4f, 0x54, 0x10,
+	0x01, 0x12, 0x0e, 0x0a, 0x0a, 0x43, 0x4f, 0x44, 0x45, 0x53, 0x50, 0x41, 0x43, 0x45, 0x53, 0x10,
+	0x02, 0x2a, 0x35, 0x0a, 0x09, 0x53, 0x63, 0x6f, 0x70, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x07,
+	0x0a, 0x03, 0x4f, 0x52, 0x47, 0x10, 0x00, 0x12, 0x0e, 0x0a, 0x0a, 0x52, 0x45, 0x50, 0x4f, 0x53,
+	0x49, 0x54, 0x4f, 0x52, 0x59, 0x10, 0x01, 0x12, 0x0f, 0x0a, 0x0b, 0x45, 0x4e, 0x56, 0x49, 0x52,
+	0x4f, 0x4e, 0x4d, 0x45, 0x4e, 0x54, 0x10, 0x02, 0x32, 0xc8, 0x14, 0x0a, 0x0d, 0x47, 0x69, 0x74,
+	0x68, 0x75, 0x62, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x6b, 0x0a, 0x10, 0x47, 0x65,
+	0x74, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x29,
+	0x2e, 0x61, 0x6c, 0x70, 0x68, 0x6f, 0x6d, 0x65, 0x67, 0x61, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75,
+	0x62, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x65,
+	0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x61, 0x6c, 0x70, 0x68,
+	0x6f, 0x6d, 0x65, 0x67, 0x61, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x47, 0x65, 0x74,
+	0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x59, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x53, 0x65,
+	0x63, 0x72, 0x65, 0x74, 0x73, 0x12, 0x23, 0x2e, 0x61, 0x6c, 0x70, 0x68, 0x6f, 0x6d, 0x65, 0x67,
+	0x61, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x65, 0x63, 0x72,
+	0x65, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x61, 0x6c, 0x70,
+	0x68, 0x6f, 0x6d, 0x65, 0x67, 0x61, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x47, 0x65,
+	0x74, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x12, 0x5f, 0x0a, 0x0c, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x65, 0x63, 0x72,
+	0x65, 0x74, 0x12, 0x25, 0x2e, 0x61, 0x6c, 0x70, 0x68, 0x6f, 0x6d, 0x65, 0x67, 0x61, 0x2e, 0x67,
+	0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x65, 0x63, 0x72,
+	0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x61, 0x6c, 0x70, 0x68,
+	0x6f, 0x6d, 0x65, 0x67, 0x61, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x12, 0x5f, 0x0a, 0x0c, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x65, 0x63,
+	0x72, 0x65, 0x74, 0x12, 0x25, 0x2e, 0x61, 0x6c, 0x70, 0x68, 0x6f, 0x6d, 0x65, 0x67, 0x61, 0x2e,
+	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x65, 0x63,
+	0x72, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x61, 0x6c, 0x70,
+	0x68, 0x6f, 0x6d, 0x65, 0x67, 0x61, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x12, 0x6b, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x53, 0x65, 0x63, 0x72, 0x65,
+	0x74, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x29, 0x2e, 0x61, 0x6c, 0x70, 0x68, 0x6f,
+	0x6d, 0x65, 0x67, 0x61, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x53,
+	0x65, 0x63, 0x72, 0x65, 0x74, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x61, 0x6c, 0x70, 0x68, 0x6f, 0x6d, 0x65, 0x67, 0x61, 0x2e,
+	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74,
+	0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x12, 0x65, 0x0a, 0x0e, 0x52, 0x6f, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x53, 0x65, 0x63,
+	0x72, 0x65, 0x74, 0x12, 0x27, 0x2e, 0x61, 0x6c, 0x70, 0x68, 0x6f, 0x6d, 0x65, 0x67, 0x61, 0x2e,
+	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x52, 0x6f, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x53,
+	0x65, 0x63, 0x72, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x61,
+	0x6c, 0x70, 0x68, 0x6f, 0x6d, 0x65, 0x67, 0x61, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
+	0x52, 0x6f, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x7a, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x53,
+	0x65, 0x63, 0x72, 0x65, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x69, 0x65,
+	0x73, 0x12, 0x2e, 0x2e, 0x61, 0x6c, 0x70, 0x68, 0x6f, 0x6d, 0x65, 0x67, 0x61, 0x2e, 0x67, 0x69,
+	0x74, 0x68, 0x75, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x52, 0x65,
+	0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x2f, 0x2e, 0x61, 0x6c, 0x70, 0x68, 0x6f, 0x6d, 0x65, 0x67, 0x61, 0x2e, 0x67, 0x69,
+	0x74, 0x68, 0x75, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x52, 0x65,
+	0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x12, 0x74, 0x0a, 0x13, 0x41, 0x64, 0x64, 0x53, 0x65, 0x63, 0x72, 0x65,
+	0x74, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x2c, 0x2e, 0x61, 0x6c,
+	0x70, 0x68, 0x6f, 0x6d, 0x65, 0x67, 0x61, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x41,
+	0x64, 0x64, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f,
+	0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2d, 0x2e, 0x61, 0x6c, 0x70, 0x68,
+	0x6f, 0x6d, 0x65, 0x67, 0x61, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x41, 0x64, 0x64,
+	0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x79,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x7d, 0x0a, 0x16, 0x52, 0x65,
+	0x6d, 0x6f, 0x76, 0x65, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x69,
+	0x74, 0x6f, 0x72, 0x79, 0x12, 0x2f, 0x2e, 0x61, 0x6c, 0x70, 0x68, 0x6f, 0x6d, 0x65, 0x67, 0x61,
+	0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x53, 0x65,
+	0x63, 0x72, 0x65, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x30, 0x2e, 0x61, 0x6c, 0x70, 0x68, 0x6f, 0x6d, 0x65, 0x67,
+	0x61, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x53,
+	0x65, 0x63, 0x72, 0x65, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5f, 0x0a, 0x0c, 0x47, 0x65, 0x74,
+	0x56, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x12, 0x25, 0x2e, 0x61, 0x6c, 0x70, 0x68,
+	0x6f, 0x6d, 0x65, 0x67, 0x61, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x47, 0x65, 0x74,
+	0x56, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x26, 0x2e, 0x61, 0x6c, 0x70, 0x68, 0x6f, 0x6d, 0x65, 0x67, 0x61, 0x2e, 0x67, 0x69, 0x74,
+	0x68, 0x75, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x56, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5c, 0x0a, 0x0b, 0x47, 0x65,
+	0x74, 0x56, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x24, 0x2e, 0x61, 0x6c, 0x70, 0x68,
+	0x6f, 0x6d, 0x65, 0x67, 0x61, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x47, 0x65, 0x74,
+	0x56, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x25, 0x2e, 0x61, 0x6c, 0x70, 0x68, 0x6f, 0x6d, 0x65, 0x67, 0x61, 0x2e, 0x67, 0x69, 0x74, 0x68,
+	0x75, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x56, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x65, 0x0a, 0x0e, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x56, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x27, 0x2e, 0x61, 0x6c, 0x70,
+	0x68, 0x6f, 0x6d, 0x65, 0x67, 0x61, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x56, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x61, 0x6c, 0x70, 0x68, 0x6f, 0x6d, 0x65, 0x67, 0x61, 0x2e,
+	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x56, 0x61, 0x72,
+	0x69, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x65, 0x0a, 0x0e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x56, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c,
+	0x65, 0x12, 0x27, 0x2e, 0x61, 0x6c, 0x70, 0x68, 0x6f, 0x6d, 0x65, 0x67, 0x61, 0x2e, 0x67, 0x69,
+	0x74, 0x68, 0x75, 0x62, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x56, 0x61, 0x72, 0x69, 0x61,
+	0x62, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x61, 0x6c, 0x70,
+	0x68, 0x6f, 0x6d, 0x65, 0x67, 0x61, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x56, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x65, 0x0a, 0x0e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x56, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x27, 0x2e, 0x61, 0x6c, 0x70, 0x68, 0x6f,
+	0x6d, 0x65, 0x67, 0x61, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x56, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x28, 0x2e, 0x61, 0x6c, 0x70, 0x68, 0x6f, 0x6d, 0x65, 0x67, 0x61, 0x2e, 0x67, 0x69,
+	0x74, 0x68, 0x75, 0x62, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x56, 0x61, 0x72, 0x69, 0x61,
+	0x62, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x68, 0x0a,
+	0x0f, 0x53, 0x79, 0x6e, 0x63, 0x45, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74,
+	0x12, 0x28, 0x2e, 0x61, 0x6c, 0x70, 0x68, 0x6f, 0x6d, 0x65, 0x67, 0x61, 0x2e, 0x67, 0x69, 0x74,
+	0x68, 0x75, 0x62, 0x2e, 0x53, 0x79, 0x6e, 0x63, 0x45, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d,
+	0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x61, 0x6c, 0x70,
+	0x68, 0x6f, 0x6d, 0x65, 0x67, 0x61, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x53, 0x79,
+	0x6e, 0x63, 0x45, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5c, 0x0a, 0x0b, 0x50, 0x75, 0x73, 0x68, 0x50,
+	0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x12, 0x24, 0x2e, 0x61, 0x6c, 0x70, 0x68, 0x6f, 0x6d, 0x65,
+	0x67, 0x61, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x50, 0x75, 0x73, 0x68, 0x50, 0x61,
+	0x63, 0x6b, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x61,
+	0x6c, 0x70, 0x68, 0x6f, 0x6d, 0x65, 0x67, 0x61, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
+	0x50, 0x75, 0x73, 0x68, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x6b, 0x0a, 0x10, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e,
+	0x65, 0x72, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x12, 0x29, 0x2e, 0x61, 0x6c, 0x70, 0x68,
+	0x6f, 0x6d, 0x65, 0x67, 0x61, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x43, 0x6f, 0x6e,
+	0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x61, 0x6c, 0x70, 0x68, 0x6f, 0x6d, 0x65, 0x67, 0x61,
+	0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65,
+	0x72, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x12, 0x5c, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65,
+	0x73, 0x12, 0x24, 0x2e, 0x61, 0x6c, 0x70, 0x68, 0x6f, 0x6d, 0x65, 0x67, 0x61, 0x2e, 0x67, 0x69,
+	0x74, 0x68, 0x75, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x61, 0x6c, 0x70, 0x68, 0x6f, 0x6d,
+	0x65, 0x67, 0x61, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x61,
+	0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x12, 0x59, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x12, 0x23,
+	0x2e, 0x61, 0x6c, 0x70, 0x68, 0x6f, 0x6d, 0x65, 0x67, 0x61, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75,
+	0x62, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x61, 0x6c, 0x70, 0x68, 0x6f, 0x6d, 0x65, 0x67, 0x61, 0x2e,
+	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x65, 0x0a, 0x0e, 0x47,
+	0x65, 0x74, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x54, 0x61, 0x67, 0x73, 0x12, 0x27, 0x2e,
+	0x61, 0x6c, 0x70, 0x68, 0x6f, 0x6d, 0x65, 0x67, 0x61, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
+	0x2e, 0x47, 0x65, 0x74, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x54, 0x61, 0x67, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x61, 0x6c, 0x70, 0x68, 0x6f, 0x6d, 0x65,
+	0x67, 0x61, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x61, 0x63,
+	0x6b, 0x61, 0x67, 0x65, 0x54, 0x61, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x12, 0x65, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65,
+	0x46, 0x69, 0x6c, 0x65, 0x12, 0x27, 0x2e, 0x61, 0x6c, 0x70, 0x68, 0x6f, 0x6d, 0x65, 0x67, 0x61,
+	0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x61, 0x63, 0x6b, 0x61,
+	0x67, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e,
+	0x61, 0x6c, 0x70, 0x68, 0x6f, 0x6d, 0x65, 0x67, 0x61, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
+	0x2e, 0x47, 0x65, 0x74, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x62, 0x0a, 0x0d, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x12, 0x26, 0x2e, 0x61, 0x6c, 0x70,
+	0x68, 0x6f, 0x6d, 0x65, 0x67, 0x61, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x27, 0x2e, 0x61, 0x6c, 0x70, 0x68, 0x6f, 0x6d, 0x65, 0x67, 0x61, 0x2e, 0x67,
+	0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x61, 0x63, 0x6b,
+	0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x62, 0x0a,
+	0x0d, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x12, 0x26,
+	0x2e, 0x61, 0x6c, 0x70, 0x68, 0x6f, 0x6d, 0x65, 0x67, 0x61, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75,
+	0x62, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x61, 0x6c, 0x70, 0x68, 0x6f, 0x6d, 0x65,
+	0x67, 0x61, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x12, 0x77, 0x0a, 0x14, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x61, 0x63, 0x6b, 0x61,
+	0x67, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x2d, 0x2e, 0x61, 0x6c, 0x70, 0x68,
+	0x6f, 0x6d, 0x65, 0x67, 0x61, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2e, 0x2e, 0x61, 0x6c, 0x70, 0x68, 0x6f,
+	0x6d, 0x65, 0x67, 0x61, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x77, 0x0a, 0x14, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x12, 0x2d, 0x2e, 0x61, 0x6c, 0x70, 0x68, 0x6f, 0x6d, 0x65, 0x67, 0x61, 0x2e, 0x67,
+	0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x61, 0x63, 0x6b,
+	0x61, 0x67, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x2e, 0x2e, 0x61, 0x6c, 0x70, 0x68, 0x6f, 0x6d, 0x65, 0x67, 0x61, 0x2e, 0x67, 0x69,
+	0x74, 0x68, 0x75, 0x62, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x61, 0x63, 0x6b, 0x61,
+	0x67, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x42, 0x35, 0x5a, 0x33, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f,
+	0x6d, 0x2f, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x2d, 0x6f, 0x6d, 0x65, 0x67, 0x61, 0x2d, 0x63, 0x6f,
+	0x72, 0x70, 0x2f, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2d, 0x73, 0x76, 0x63, 0x2f, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x33,
+}
+
+var (
+	file_proto_github_github_proto_rawDescOnce sync.Once
+	file_proto_github_github_proto_rawDescData = file_proto_github_github_proto_rawDesc
+)
+
+func file_proto_github_github_proto_rawDescGZIP() []byte {
+	file_proto_github_github_proto_rawDescOnce.Do(func() {
+		file_proto_github_github_proto_rawDescData = protoimpl.X.CompressGZIP(file_proto_github_github_proto_rawDescData)
+	})
+	return file_proto_github_github_proto_rawDescData
+}
+
+var file_proto_github_github_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_proto_github_github_proto_msgTypes = make([]protoimpl.MessageInfo, 61)
+var file_proto_github_github_proto_goTypes = []interface{}{
+	(SecretStore)(0),                       // 0: alphomega.github.SecretStore
+	(ScopeType)(0),                         // 1: alphomega.github.ScopeType
+	(*DeletePackageRequest)(nil),           // 2: alphomega.github.DeletePackageRequest
+	(*DeletePackageResponse)(nil),          // 3: alphomega.github.DeletePackageResponse
+	(*GetSecretContentRequest)(nil),        // 4: alphomega.github.GetSecretContentRequest
+	(*GetSecretContentResponse)(nil),       // 5: alphomega.github.GetSecretContentResponse
+	(*SyncEnvironmentRequest)(nil),         // 6: alphomega.github.SyncEnvironmentRequest
+	(*SyncEnvironmentResponse)(nil),        // 7: alphomega.github.SyncEnvironmentResponse
+	(*DeleteSecretRequest)(nil),            // 8: alphomega.github.DeleteSecretRequest
+	(*DeleteSecretResponse)(nil),           // 9: alphomega.github.DeleteSecretResponse
+	(*CreateSecretRequest)(nil),            // 10: alphomega.github.CreateSecretRequest
+	(*CreateSecretResponse)(nil),           // 11: alphomega.github.CreateSecretResponse
+	(*GetSecretsRequest)(nil),              // 12: alphomega.github.GetSecretsRequest
+	(*GetSecretsResponse)(nil),             // 13: alphomega.github.GetSecretsResponse
+	(*Secret)(nil),                         // 14: alphomega.github.Secret
+	(*SecretScope)(nil),                    // 15: alphomega.github.SecretScope
+	(*GetSecretHistoryRequest)(nil),        // 16: alphomega.github.GetSecretHistoryRequest
+	(*GetSecretHistoryResponse)(nil),       // 17: alphomega.github.GetSecretHistoryResponse
+	(*SecretVersion)(nil),                  // 18: alphomega.github.SecretVersion
+	(*RollbackSecretRequest)(nil),          // 19: alphomega.github.RollbackSecretRequest
+	(*RollbackSecretResponse)(nil),         // 20: alphomega.github.RollbackSecretResponse
+	(*GetSecretRepositoriesRequest)(nil),   // 21: alphomega.github.GetSecretRepositoriesRequest
+	(*GetSecretRepositoriesResponse)(nil),  // 22: alphomega.github.GetSecretRepositoriesResponse
+	(*AddSecretRepositoryRequest)(nil),     // 23: alphomega.github.AddSecretRepositoryRequest
+	(*AddSecretRepositoryResponse)(nil),    // 24: alphomega.github.AddSecretRepositoryResponse
+	(*RemoveSecretRepositoryRequest)(nil),  // 25: alphomega.github.RemoveSecretRepositoryRequest
+	(*RemoveSecretRepositoryResponse)(nil), // 26: alphomega.github.RemoveSecretRepositoryResponse
+	(*Variable)(nil),                       // 27: alphomega.github.Variable
+	(*GetVariablesRequest)(nil),            // 28: alphomega.github.GetVariablesRequest
+	(*GetVariablesResponse)(nil),           // 29: alphomega.github.GetVariablesResponse
+	(*GetVariableRequest)(nil),             // 30: alphomega.github.GetVariableRequest
+	(*GetVariableResponse)(nil),            // 31: alphomega.github.GetVariableResponse
+	(*CreateVariableRequest)(nil),          // 32: alphomega.github.CreateVariableRequest
+	(*CreateVariableResponse)(nil),         // 33: alphomega.github.CreateVariableResponse
+	(*UpdateVariableRequest)(nil),          // 34: alphomega.github.UpdateVariableRequest
+	(*UpdateVariableResponse)(nil),         // 35: alphomega.github.UpdateVariableResponse
+	(*DeleteVariableRequest)(nil),          // 36: alphomega.github.DeleteVariableRequest
+	(*DeleteVariableResponse)(nil),         // 37: alphomega.github.DeleteVariableResponse
+	(*Package)(nil),                        // 38: alphomega.github.Package
+	(*SimplePackage)(nil),                  // 39: alphomega.github.SimplePackage
+	(*GitPackage)(nil),                     // 40: alphomega.github.GitPackage
+	(*File)(nil),                           // 41: alphomega.github.File
+	(*PackageIdentifier)(nil),              // 42: alphomega.github.PackageIdentifier
+	(*GetPackageTagsRequest)(nil),          // 43: alphomega.github.GetPackageTagsRequest
+	(*GetPackageTagsResponse)(nil),         // 44: alphomega.github.GetPackageTagsResponse
+	(*PackageTag)(nil),                     // 45: alphomega.github.PackageTag
+	(*DeletePackageVersionRequest)(nil),    // 46: alphomega.github.DeletePackageVersionRequest
+	(*DeletePackageVersionResponse)(nil),   // 47: alphomega.github.DeletePackageVersionResponse
+	(*CreatePackageVersionRequest)(nil),    // 48: alphomega.github.CreatePackageVersionRequest
+	(*CreatePackageVersionResponse)(nil),   // 49: alphomega.github.CreatePackageVersionResponse
+	(*GetPackageFileRequest)(nil),          // 50: alphomega.github.GetPackageFileRequest
+	(*GetPackageFileResponse)(nil),         // 51: alphomega.github.GetPackageFileResponse
+	(*GetPackageResponse)(nil),             // 52: alphomega.github.GetPackageResponse
+	(*PackageVersion)(nil),                 // 53: alphomega.github.PackageVersion
+	(*ContainerPackageRequest)(nil),        // 54: alphomega.github.ContainerPackageRequest
+	(*ContainerPackageResponse)(nil),       // 55: alphomega.github.ContainerPackageResponse
+	(*PushPackageRequest)(nil),             // 56: alphomega.github.PushPackageRequest
+	(*PushPackageResponse)(nil),            // 57: alphomega.github.PushPackageResponse
+	(*CreatePackageRequest)(nil),           // 58: alphomega.github.CreatePackageRequest
+	(*CreatePackageResponse)(nil),          // 59: alphomega.github.CreatePackageResponse
+	(*GetPackageRequest)(nil),              // 60: alphomega.github.GetPackageRequest
+	(*GetPackagesRequest)(nil),             // 61: alphomega.github.GetPackagesRequest
+	(*GetPackagesResponse)(nil),            // 62: alphomega.github.GetPackagesResponse
+	(*docker.Container)(nil),               // 63: alphomega.docker.Container
+}
+var file_proto_github_github_proto_depIdxs = []int32{
+	15, // 0: alphomega.github.GetSecretContentRequest.scope:type_name -> alphomega.github.SecretScope
+	15, // 1: alphomega.github.DeleteSecretRequest.scope:type_name -> alphomega.github.SecretScope
+	15, // 2: alphomega.github.CreateSecretRequest.scope:type_name -> alphomega.github.SecretScope
+	15, // 3: alphomega.github.GetSecretsRequest.scope:type_name -> alphomega.github.SecretScope
+	14, // 4: alphomega.github.GetSecretsResponse.secrets:type_name -> alphomega.github.Secret
+	1,  // 5: alphomega.github.SecretScope.type:type_name -> alphomega.github.ScopeType
+	0,  // 6: alphomega.github.SecretScope.store:type_name -> alphomega.github.SecretStore
+	15, // 7: alphomega.github.GetSecretHistoryRequest.scope:type_name -> alphomega.github.SecretScope
+	18, // 8: alphomega.github.GetSecretHistoryResponse.versions:type_name -> alphomega.github.SecretVersion
+	15, // 9: alphomega.github.RollbackSecretRequest.scope:type_name -> alphomega.github.SecretScope
+	0,  // 10: alphomega.github.GetSecretRepositoriesRequest.store:type_name -> alphomega.github.SecretStore
+	0,  // 11: alphomega.github.AddSecretRepositoryRequest.store:type_name -> alphomega.github.SecretStore
+	0,  // 12: alphomega.github.RemoveSecretRepositoryRequest.store:type_name -> alphomega.github.SecretStore
+	15, // 13: alphomega.github.GetVariablesRequest.scope:type_name -> alphomega.github.SecretScope
+	27, // 14: alphomega.github.GetVariablesResponse.variables:type_name -> alphomega.github.Variable
+	15, // 15: alphomega.github.GetVariableRequest.scope:type_name -> alphomega.github.SecretScope
+	27, // 16: alphomega.github.GetVariableResponse.variable:type_name -> alphomega.github.Variable
+	15, // 17: alphomega.github.CreateVariableRequest.scope:type_name -> alphomega.github.SecretScope
+	15, // 18: alphomega.github.UpdateVariableRequest.scope:type_name -> alphomega.github.SecretScope
+	15, // 19: alphomega.github.DeleteVariableRequest.scope:type_name -> alphomega.github.SecretScope
+	41, // 20: alphomega.github.Package.files:type_name -> alphomega.github.File
+	63, // 21: alphomega.github.Package.containers:type_name -> alphomega.docker.Container
+	53, // 22: alphomega.github.GetPackageResponse.versions:type_name -> alphomega.github.PackageVersion
+	39, // 23: alphomega.github.GetPackagesResponse.packages:type_name -> alphomega.github.SimplePackage
+	4,  // 24: alphomega.github.GithubService.GetSecretContent:input_type -> alphomega.github.GetSecretContentRequest
+	12, // 25: alphomega.github.GithubService.GetSecrets:input_type -> alphomega.github.GetSecretsRequest
+	10, // 26: alphomega.github.GithubService.CreateSecret:input_type -> alphomega.github.CreateSecretRequest
+	8,  // 27: alphomega.github.GithubService.DeleteSecret:input_type -> alphomega.github.DeleteSecretRequest
+	16, // 28: alphomega.github.GithubService.GetSecretHistory:input_type -> alphomega.github.GetSecretHistoryRequest
+	19, // 29: alphomega.github.GithubService.RollbackSecret:input_type -> alphomega.github.RollbackSecretRequest
+	21, // 30: alphomega.github.GithubService.GetSecretRepositories:input_type -> alphomega.github.GetSecretRepositoriesRequest
+	23, // 31: alphomega.github.GithubService.AddSecretRepository:input_type -> alphomega.github.AddSecretRepositoryRequest
+	25, // 32: alphomega.github.GithubService.RemoveSecretRepository:input_type -> alphomega.github.RemoveSecretRepositoryRequest
+	28, // 33: alphomega.github.GithubService.GetVariables:input_type -> alphomega.github.GetVariablesRequest
+	30, // 34: alphomega.github.GithubService.GetVariable:input_type -> alphomega.github.GetVariableRequest
+	32, // 35: alphomega.github.GithubService.CreateVariable:input_type -> alphomega.github.CreateVariableRequest
+	34, // 36: alphomega.github.GithubService.UpdateVariable:input_type -> alphomega.github.UpdateVariableRequest
+	36, // 37: alphomega.github.GithubService.DeleteVariable:input_type -> alphomega.github.DeleteVariableRequest
+	6,  // 38: alphomega.github.GithubService.SyncEnvironment:input_type -> alphomega.github.SyncEnvironmentRequest
+	56, // 39: alphomega.github.GithubService.PushPackage:input_type -> alphomega.github.PushPackageRequest
+	54, // 40: alphomega.github.GithubService.ContainerPackage:input_type -> alphomega.github.ContainerPackageRequest
+	61, // 41: alphomega.github.GithubService.GetPackages:input_type -> alphomega.github.GetPackagesRequest
+	60, // 42: alphomega.github.GithubService.GetPackage:input_type -> alphomega.github.GetPackageRequest
+	43, // 43: alphomega.github.GithubService.GetPackageTags:input_type -> alphomega.github.GetPackageTagsRequest
+	50, // 44: alphomega.github.GithubService.GetPackageFile:input_type -> alphomega.github.GetPackageFileRequest
+	58, // 45: alphomega.github.GithubService.CreatePackage:input_type -> alphomega.github.CreatePackageRequest
+	2,  // 46: alphomega.github.GithubService.DeletePackage:input_type -> alphomega.github.DeletePackageRequest
+	48, // 47: alphomega.github.GithubService.CreatePackageVersion:input_type -> alphomega.github.CreatePackageVersionRequest
+	46, // 48: alphomega.github.GithubService.DeletePackageVersion:input_type -> alphomega.github.DeletePackageVersionRequest
+	5,  // 49: alphomega.github.GithubService.GetSecretContent:output_type -> alphomega.github.GetSecretContentResponse
+	13, // 50: alphomega.github.GithubService.GetSecrets:output_type -> alphomega.github.GetSecretsResponse
+	11, // 51: alphomega.github.GithubService.CreateSecret:output_type -> alphomega.github.CreateSecretResponse
+	9,  // 52: alphomega.github.GithubService.DeleteSecret:output_type -> alphomega.github.DeleteSecretResponse
+	17, // 53: alphomega.github.GithubService.GetSecretHistory:output_type -> alphomega.github.GetSecretHistoryResponse
+	20, // 54: alphomega.github.GithubService.RollbackSecret:output_type -> alphomega.github.RollbackSecretResponse
+	22, // 55: alphomega.github.GithubService.GetSecretRepositories:output_type -> alphomega.github.GetSecretRepositoriesResponse
+	24, // 56: alphomega.github.GithubService.AddSecretRepository:output_type -> alphomega.github.AddSecretRepositoryResponse
+	26, // 57: alphomega.github.GithubService.RemoveSecretRepository:output_type -> alphomega.github.RemoveSecretRepositoryResponse
+	29, // 58: alphomega.github.GithubService.GetVariables:output_type -> alphomega.github.GetVariablesResponse
+	31, // 59: alphomega.github.GithubService.GetVariable:output_type -> alphomega.github.GetVariableResponse
+	33, // 60: alphomega.github.GithubService.CreateVariable:output_type -> alphomega.github.CreateVariableResponse
+	35, // 61: alphomega.github.GithubService.UpdateVariable:output_type -> alphomega.github.UpdateVariableResponse
+	37, // 62: alphomega.github.GithubService.DeleteVariable:output_type -> alphomega.github.DeleteVariableResponse
+	7,  // 63: alphomega.github.GithubService.SyncEnvironment:output_type -> alphomega.github.SyncEnvironmentResponse
+	57, // 64: alphomega.github.GithubService.PushPackage:output_type -> alphomega.github.PushPackageResponse
+	55, // 65: alphomega.github.GithubService.ContainerPackage:output_type -> alphomega.github.ContainerPackageResponse
+	62, // 66: alphomega.github.GithubService.GetPackages:output_type -> alphomega.github.GetPackagesResponse
+	52, // 67: alphomega.github.GithubService.GetPackage:output_type -> alphomega.github.GetPackageResponse
+	44, // 68: alphomega.github.GithubService.GetPackageTags:output_type -> alphomega.github.GetPackageTagsResponse
+	51, // 69: alphomega.github.GithubService.GetPackageFile:output_type -> alphomega.github.GetPackageFileResponse
+	59, // 70: alphomega.github.GithubService.CreatePackage:output_type -> alphomega.github.CreatePackageResponse
+	3,  // 71: alphomega.github.GithubService.DeletePackage:output_type -> alphomega.github.DeletePackageResponse
+	49, // 72: alphomega.github.GithubService.CreatePackageVersion:output_type -> alphomega.github.CreatePackageVersionResponse
+	47, // 73: alphomega.github.GithubService.DeletePackageVersion:output_type -> alphomega.github.DeletePackageVersionResponse
+	49, // [49:74] is the sub-list for method output_type
+	24, // [24:49] is the sub-list for method input_type
+	24, // [24:24] is the sub-list for extension type_name
+	24, // [24:24] is the sub-list for extension extendee
+	0,  // [0:24] is the sub-list for field type_name
+}
+
+func init() { file_proto_github_github_proto_init() }
+func file_proto_github_github_proto_init() {
+	if File_proto_github_github_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_proto_github_github_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeletePackageRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_github_github_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeletePackageResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_github_github_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetSecretContentRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_github_github_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetSecretContentResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_github_github_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SyncEnvironmentRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_github_github_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SyncEnvironmentResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_github_github_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteSecretRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_github_github_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteSecretResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_github_github_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateSecretRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_github_github_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateSecretResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_github_github_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetSecretsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_github_github_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetSecretsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_github_github_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Secret); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_github_github_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SecretScope); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_github_github_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetSecretHistoryRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_github_github_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetSecretHistoryResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_github_github_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SecretVersion); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_github_github_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RollbackSecretRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_github_github_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RollbackSecretResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_github_github_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetSecretRepositoriesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
 		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use GetPackagesResponse.ProtoReflect.Descriptor instead.
-func (*GetPackagesResponse) Descriptor() ([]byte, []int) {
-	return file_proto_github_github_proto_rawDescGZIP(), []int{37}
-}
-
-func (x *GetPackagesResponse) GetPackages() []*SimplePackage {
-	if x != nil {
-		return x.Packages
-	}
-	return nil
-}
-
-var File_proto_github_github_proto protoreflect.FileDescriptor
-
-var file_proto_github_github_proto_rawDesc = []byte{
-	0x0a, 0x19, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2f, 0x67,
-	0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x10, 0x61, 0x6c, 0x70,
-	0x68, 0x6f, 0x6d, 0x65, 0x67, 0x61, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x1a, 0x19, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x64, 0x6f, 0x63, 0x6b, 0x65, 0x72, 0x2f, 0x64, 0x6f, 0x63, 0x6b,
-	0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x2a, 0x0a, 0x14, 0x44, 0x65, 0x6c, 0x65,
-	0x74, 0x65, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
-	0x6e, 0x61, 0x6d, 0x65, 0x22, 0x2f, 0x0a, 0x15, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x61,
-	0x63, 0x6b, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a,
-	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x73,
-	0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x2d, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x53, 0x65, 0x63, 0x72,
-	0x65, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
-	0x6e, 0x61, 0x6d, 0x65, 0x22, 0x34, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x53, 0x65, 0x63, 0x72, 0x65,
-	0x74, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0c, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x22, 0x18, 0x0a, 0x16, 0x53, 0x79,
-	0x6e, 0x63, 0x45, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x22, 0x31, 0x0a, 0x17, 0x53, 0x79, 0x6e, 0x63, 0x45, 0x6e, 0x76, 0x69,
-	0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
-	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x29, 0x0a, 0x13, 0x44, 0x65, 0x6c, 0x65, 0x74,
-	0x65, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12,
-	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
-	0x6d, 0x65, 0x22, 0x2e, 0x0a, 0x14, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x65, 0x63, 0x72,
-	0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74,
-	0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74,
-	0x75, 0x73, 0x22, 0x43, 0x0a, 0x13, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x65, 0x63, 0x72,
-	0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
-	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a,
-	0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07,
-	0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x22, 0x2e, 0x0a, 0x14, 0x43, 0x72, 0x65, 0x61, 0x74,
-	0x65, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
-	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x13, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x53, 0x65,
-	0x63, 0x72, 0x65, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x48, 0x0a, 0x12,
-	0x47, 0x65, 0x74, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x32, 0x0a, 0x07, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x73, 0x18, 0x01, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x61, 0x6c, 0x70, 0x68, 0x6f, 0x6d, 0x65, 0x67, 0x61, 0x2e,
-	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x52, 0x07, 0x73,
-	0x65, 0x63, 0x72, 0x65, 0x74, 0x73, 0x22, 0x78, 0x0a, 0x06, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74,
-	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
-	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41,
-	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64,
-	0x41, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74,
-	0x12, 0x1e, 0x0a, 0x0a, 0x76, 0x69, 0x73, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x76, 0x69, 0x73, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79,
-	0x22, 0x88, 0x01, 0x0a, 0x07, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x12, 0x12, 0x0a, 0x04,
-	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
-	0x12, 0x2c, 0x0a, 0x05, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x16, 0x2e, 0x61, 0x6c, 0x70, 0x68, 0x6f, 0x6d, 0x65, 0x67, 0x61, 0x2e, 0x67, 0x69, 0x74, 0x68,
-	0x75, 0x62, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x05, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x12, 0x3b,
-	0x0a, 0x0a, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x73, 0x18, 0x03, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x61, 0x6c, 0x70, 0x68, 0x6f, 0x6d, 0x65, 0x67, 0x61, 0x2e, 0x64,
-	0x6f, 0x63, 0x6b, 0x65, 0x72, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x52,
-	0x0a, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x73, 0x22, 0xc5, 0x01, 0x0a, 0x0d,
-	0x53, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x12, 0x12, 0x0a,
-	0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70,
-	0x65, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52,
-	0x04, 0x73, 0x69, 0x7a, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74,
-	0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x10, 0x0a,
-	0x03, 0x73, 0x68, 0x61, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x73, 0x68, 0x61, 0x12,
-	0x16, 0x0a, 0x06, 0x67, 0x69, 0x74, 0x55, 0x72, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x06, 0x67, 0x69, 0x74, 0x55, 0x72, 0x6c, 0x12, 0x18, 0x0a, 0x07, 0x68, 0x74, 0x6d, 0x6c, 0x55,
-	0x72, 0x6c, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x68, 0x74, 0x6d, 0x6c, 0x55, 0x72,
-	0x6c, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x55, 0x72, 0x6c,
-	0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64,
-	0x55, 0x72, 0x6c, 0x22, 0x9e, 0x02, 0x0a, 0x0a, 0x47, 0x69, 0x74, 0x50, 0x61, 0x63, 0x6b, 0x61,
-	0x67, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02,
-	0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65,
-	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x76, 0x65, 0x72,
-	0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x76, 0x69, 0x73, 0x69, 0x62, 0x69, 0x6c, 0x69,
-	0x74, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x76, 0x69, 0x73, 0x69, 0x62, 0x69,
-	0x6c, 0x69, 0x74, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x12, 0x18, 0x0a, 0x07, 0x68, 0x74, 0x6d, 0x6c, 0x55, 0x72,
-	0x6c, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x68, 0x74, 0x6d, 0x6c, 0x55, 0x72, 0x6c,
-	0x12, 0x18, 0x0a, 0x07, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x49, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28,
-	0x03, 0x52, 0x07, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x6f, 0x77,
-	0x6e, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6f,
-	0x77, 0x6e, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x6f, 0x77, 0x6e, 0x65,
-	0x72, 0x4e, 0x6f, 0x64, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6f, 0x77, 0x6e,
-	0x65, 0x72, 0x4e, 0x6f, 0x64, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x54,
-	0x79, 0x70, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6f, 0x77, 0x6e, 0x65, 0x72,
-	0x54, 0x79, 0x70, 0x65, 0x22, 0x34, 0x0a, 0x04, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x12, 0x0a, 0x04,
-	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
-	0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x0c, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x22, 0x39, 0x0a, 0x11, 0x50, 0x61,
-	0x63, 0x6b, 0x61, 0x67, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x12,
-	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
-	0x61, 0x6d, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x74, 0x61, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x03, 0x74, 0x61, 0x67, 0x22, 0x2b, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x50, 0x61, 0x63, 0x6b,
-	0x61, 0x67, 0x65, 0x54, 0x61, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12,
-	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
-	0x6d, 0x65, 0x22, 0x2c, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65,
-	0x54, 0x61, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04,
-	0x74, 0x61, 0x67, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x74, 0x61, 0x67, 0x73,
-	0x22, 0x32, 0x0a, 0x0a, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x54, 0x61, 0x67, 0x12, 0x12,
-	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
-	0x6d, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x74, 0x61, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x03, 0x74, 0x61, 0x67, 0x22, 0x6e, 0x0a, 0x1b, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x61,
-	0x63, 0x6b, 0x61, 0x67, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x74, 0x61, 0x67, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x74, 0x61, 0x67, 0x12, 0x1d, 0x0a, 0x07, 0x76, 0x65, 0x72,
-	0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x48, 0x00, 0x52, 0x07, 0x76, 0x65,
-	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x76, 0x65, 0x72,
-	0x73, 0x69, 0x6f, 0x6e, 0x22, 0x36, 0x0a, 0x1c, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x61,
-	0x63, 0x6b, 0x61, 0x67, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x5d, 0x0a, 0x1b,
-	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x56, 0x65, 0x72,
-	0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e,
-	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12,
-	0x10, 0x0a, 0x03, 0x74, 0x61, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x74, 0x61,
-	0x67, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x0c, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x22, 0x36, 0x0a, 0x1c, 0x43,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x56, 0x65, 0x72, 0x73,
-	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73,
-	0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x73, 0x74, 0x61,
-	0x74, 0x75, 0x73, 0x22, 0x3f, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67,
-	0x65, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04,
-	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
-	0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
-	0x70, 0x61, 0x74, 0x68, 0x22, 0x32, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x50, 0x61, 0x63, 0x6b, 0x61,
-	0x67, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18,
-	0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52,
-	0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x22, 0x52, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x50,
-	0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3c,
-	0x0a, 0x08, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x20, 0x2e, 0x61, 0x6c, 0x70, 0x68, 0x6f, 0x6d, 0x65, 0x67, 0x61, 0x2e, 0x67, 0x69, 0x74,
-	0x68, 0x75, 0x62, 0x2e, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69,
-	0x6f, 0x6e, 0x52, 0x08, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x9a, 0x02, 0x0a,
-	0x0e, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12,
-	0x1a, 0x0a, 0x08, 0x72, 0x65, 0x70, 0x6f, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x08, 0x72, 0x65, 0x70, 0x6f, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x72,
-	0x65, 0x70, 0x6f, 0x50, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72,
-	0x65, 0x70, 0x6f, 0x50, 0x61, 0x74, 0x68, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x70, 0x6f, 0x53,
-	0x68, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x72, 0x65, 0x70, 0x6f, 0x53, 0x68,
-	0x61, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x70, 0x6f, 0x4c, 0x69, 0x6e, 0x6b, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x70, 0x6f, 0x4c, 0x69, 0x6e, 0x6b, 0x12, 0x21, 0x0a,
-	0x09, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03,
-	0x48, 0x00, 0x52, 0x09, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x88, 0x01, 0x01,
-	0x12, 0x23, 0x0a, 0x0a, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x53, 0x68, 0x61, 0x18, 0x06,
-	0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x0a, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x53,
-	0x68, 0x61, 0x88, 0x01, 0x01, 0x12, 0x25, 0x0a, 0x0b, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
-	0x4c, 0x69, 0x6e, 0x6b, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x48, 0x02, 0x52, 0x0b, 0x76, 0x65,
-	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x6e, 0x6b, 0x88, 0x01, 0x01, 0x42, 0x0c, 0x0a, 0x0a,
-	0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x76,
-	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x53, 0x68, 0x61, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x76, 0x65,
-	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x6e, 0x6b, 0x22, 0x3d, 0x0a, 0x17, 0x43, 0x6f, 0x6e,
-	0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
-	0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x54, 0x0a, 0x18, 0x43, 0x6f, 0x6e, 0x74,
-	0x61, 0x69, 0x6e, 0x65, 0x72, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x20, 0x0a, 0x0b,
-	0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x03, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x49, 0x64, 0x22, 0x5a,
-	0x0a, 0x12, 0x50, 0x75, 0x73, 0x68, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x74, 0x61, 0x67, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x74, 0x61, 0x67, 0x12, 0x1e, 0x0a, 0x0a, 0x76, 0x65,
-	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x53, 0x48, 0x41, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
-	0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x53, 0x48, 0x41, 0x22, 0x2d, 0x0a, 0x13, 0x50, 0x75,
-	0x73, 0x68, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x03, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x2a, 0x0a, 0x14, 0x43, 0x72, 0x65,
-	0x61, 0x74, 0x65, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x2f, 0x0a, 0x15, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50,
-	0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16,
-	0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06,
-	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x27, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x50, 0x61, 0x63,
-	0x6b, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e,
-	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22,
-	0x14, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x52, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x50, 0x61, 0x63, 0x6b,
-	0x61, 0x67, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3b, 0x0a, 0x08,
-	0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f,
-	0x2e, 0x61, 0x6c, 0x70, 0x68, 0x6f, 0x6d, 0x65, 0x67, 0x61, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75,
-	0x62, 0x2e, 0x53, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x52,
-	0x08, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x32, 0x8f, 0x0c, 0x0a, 0x0d, 0x47, 0x69,
-	0x74, 0x68, 0x75, 0x62, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x6b, 0x0a, 0x10, 0x47,
-	0x65, 0x74, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12,
-	0x29, 0x2e, 0x61, 0x6c, 0x70, 0x68, 0x6f, 0x6d, 0x65, 0x67, 0x61, 0x2e, 0x67, 0x69, 0x74, 0x68,
-	0x75, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x74,
-	0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x61, 0x6c, 0x70,
-	0x68, 0x6f, 0x6d, 0x65, 0x67, 0x61, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x47, 0x65,
-	0x74, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x59, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x53,
-	0x65, 0x63, 0x72, 0x65, 0x74, 0x73, 0x12, 0x23, 0x2e, 0x61, 0x6c, 0x70, 0x68, 0x6f, 0x6d, 0x65,
-	0x67, 0x61, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x65, 0x63,
-	0x72, 0x65, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x61, 0x6c,
-	0x70, 0x68, 0x6f, 0x6d, 0x65, 0x67, 0x61, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x47,
-	0x65, 0x74, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x00, 0x12, 0x5f, 0x0a, 0x0c, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x65, 0x63,
-	0x72, 0x65, 0x74, 0x12, 0x25, 0x2e, 0x61, 0x6c, 0x70, 0x68, 0x6f, 0x6d, 0x65, 0x67, 0x61, 0x2e,
-	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x65, 0x63,
-	0x72, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x61, 0x6c, 0x70,
-	0x68, 0x6f, 0x6d, 0x65, 0x67, 0x61, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x43, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x00, 0x12, 0x5f, 0x0a, 0x0c, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x65,
-	0x63, 0x72, 0x65, 0x74, 0x12, 0x25, 0x2e, 0x61, 0x6c, 0x70, 0x68, 0x6f, 0x6d, 0x65, 0x67, 0x61,
-	0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x65,
-	0x63, 0x72, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x61, 0x6c,
-	0x70, 0x68, 0x6f, 0x6d, 0x65, 0x67, 0x61, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x44,
-	0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x68, 0x0a, 0x0f, 0x53, 0x79, 0x6e, 0x63, 0x45, 0x6e, 0x76,
-	0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x28, 0x2e, 0x61, 0x6c, 0x70, 0x68, 0x6f,
-	0x6d, 0x65, 0x67, 0x61, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x53, 0x79, 0x6e, 0x63,
-	0x45, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x29, 0x2e, 0x61, 0x6c, 0x70, 0x68, 0x6f, 0x6d, 0x65, 0x67, 0x61, 0x2e, 0x67,
-	0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x53, 0x79, 0x6e, 0x63, 0x45, 0x6e, 0x76, 0x69, 0x72, 0x6f,
-	0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
-	0x5c, 0x0a, 0x0b, 0x50, 0x75, 0x73, 0x68, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x12, 0x24,
-	0x2e, 0x61, 0x6c, 0x70, 0x68, 0x6f, 0x6d, 0x65, 0x67, 0x61, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75,
-	0x62, 0x2e, 0x50, 0x75, 0x73, 0x68, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x61, 0x6c, 0x70, 0x68, 0x6f, 0x6d, 0x65, 0x67, 0x61,
-	0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x50, 0x75, 0x73, 0x68, 0x50, 0x61, 0x63, 0x6b,
-	0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x6b, 0x0a,
-	0x10, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67,
-	0x65, 0x12, 0x29, 0x2e, 0x61, 0x6c, 0x70, 0x68, 0x6f, 0x6d, 0x65, 0x67, 0x61, 0x2e, 0x67, 0x69,
-	0x74, 0x68, 0x75, 0x62, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x50, 0x61,
-	0x63, 0x6b, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x61,
-	0x6c, 0x70, 0x68, 0x6f, 0x6d, 0x65, 0x67, 0x61, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
-	0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5c, 0x0a, 0x0b, 0x47, 0x65,
-	0x74, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x12, 0x24, 0x2e, 0x61, 0x6c, 0x70, 0x68,
-	0x6f, 0x6d, 0x65, 0x67, 0x61, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x47, 0x65, 0x74,
-	0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x25, 0x2e, 0x61, 0x6c, 0x70, 0x68, 0x6f, 0x6d, 0x65, 0x67, 0x61, 0x2e, 0x67, 0x69, 0x74, 0x68,
-	0x75, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x59, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x50,
-	0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x12, 0x23, 0x2e, 0x61, 0x6c, 0x70, 0x68, 0x6f, 0x6d, 0x65,
-	0x67, 0x61, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x61, 0x63,
-	0x6b, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x61, 0x6c,
-	0x70, 0x68, 0x6f, 0x6d, 0x65, 0x67, 0x61, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x47,
-	0x65, 0x74, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x00, 0x12, 0x65, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67,
-	0x65, 0x54, 0x61, 0x67, 0x73, 0x12, 0x27, 0x2e, 0x61, 0x6c, 0x70, 0x68, 0x6f, 0x6d, 0x65, 0x67,
-	0x61, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x61, 0x63, 0x6b,
-	0x61, 0x67, 0x65, 0x54, 0x61, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28,
-	0x2e, 0x61, 0x6c, 0x70, 0x68, 0x6f, 0x6d, 0x65, 0x67, 0x61, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75,
-	0x62, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x54, 0x61, 0x67, 0x73,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x65, 0x0a, 0x0e, 0x47, 0x65,
-	0x74, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x27, 0x2e, 0x61,
-	0x6c, 0x70, 0x68, 0x6f, 0x6d, 0x65, 0x67, 0x61, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
-	0x47, 0x65, 0x74, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x61, 0x6c, 0x70, 0x68, 0x6f, 0x6d, 0x65, 0x67,
-	0x61, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x61, 0x63, 0x6b,
-	0x61, 0x67, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x00, 0x12, 0x62, 0x0a, 0x0d, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x61, 0x63, 0x6b, 0x61,
-	0x67, 0x65, 0x12, 0x26, 0x2e, 0x61, 0x6c, 0x70, 0x68, 0x6f, 0x6d, 0x65, 0x67, 0x61, 0x2e, 0x67,
-	0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x61, 0x63, 0x6b,
-	0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x61, 0x6c, 0x70,
-	0x68, 0x6f, 0x6d, 0x65, 0x67, 0x61, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x43, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x62, 0x0a, 0x0d, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50,
-	0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x12, 0x26, 0x2e, 0x61, 0x6c, 0x70, 0x68, 0x6f, 0x6d, 0x65,
-	0x67, 0x61, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
-	0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27,
-	0x2e, 0x61, 0x6c, 0x70, 0x68, 0x6f, 0x6d, 0x65, 0x67, 0x61, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75,
-	0x62, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x77, 0x0a, 0x14, 0x43, 0x72, 0x65,
-	0x61, 0x74, 0x65, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f,
-	0x6e, 0x12, 0x2d, 0x2e, 0x61, 0x6c, 0x70, 0x68, 0x6f, 0x6d, 0x65, 0x67, 0x61, 0x2e, 0x67, 0x69,
-	0x74, 0x68, 0x75, 0x62, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x61, 0x63, 0x6b, 0x61,
-	0x67, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x2e, 0x2e, 0x61, 0x6c, 0x70, 0x68, 0x6f, 0x6d, 0x65, 0x67, 0x61, 0x2e, 0x67, 0x69, 0x74,
-	0x68, 0x75, 0x62, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67,
-	0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x00, 0x12, 0x77, 0x0a, 0x14, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x61, 0x63, 0x6b,
-	0x61, 0x67, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x2d, 0x2e, 0x61, 0x6c, 0x70,
-	0x68, 0x6f, 0x6d, 0x65, 0x67, 0x61, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x44, 0x65,
-	0x6c, 0x65, 0x74, 0x65, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69,
-	0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2e, 0x2e, 0x61, 0x6c, 0x70, 0x68,
-	0x6f, 0x6d, 0x65, 0x67, 0x61, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x44, 0x65, 0x6c,
-	0x65, 0x74, 0x65, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f,
-	0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x42, 0x35, 0x5a, 0x33, 0x67,
-	0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x2d,
-	0x6f, 0x6d, 0x65, 0x67, 0x61, 0x2d, 0x63, 0x6f, 0x72, 0x70, 0x2f, 0x67, 0x69, 0x74, 0x68, 0x75,
-	0x62, 0x2d, 0x73, 0x76, 0x63, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x69, 0x74, 0x68,
-	0x75, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
-}
-
-var (
-	file_proto_github_github_proto_rawDescOnce sync.Once
-	file_proto_github_github_proto_rawDescData = file_proto_github_github_proto_rawDesc
-)
-
-func file_proto_github_github_proto_rawDescGZIP() []byte {
-	file_proto_github_github_proto_rawDescOnce.Do(func() {
-		file_proto_github_github_proto_rawDescData = protoimpl.X.CompressGZIP(file_proto_github_github_proto_rawDescData)
-	})
-	return file_proto_github_github_proto_rawDescData
-}
-
-var file_proto_github_github_proto_msgTypes = make([]protoimpl.MessageInfo, 38)
-var file_proto_github_github_proto_goTypes = []interface{}{
-	(*DeletePackageRequest)(nil),         // 0: alphomega.github.DeletePackageRequest
-	(*DeletePackageResponse)(nil),        // 1: alphomega.github.DeletePackageResponse
-	(*GetSecretContentRequest)(nil),      // 2: alphomega.github.GetSecretContentRequest
-	(*GetSecretContentResponse)(nil),     // 3: alphomega.github.GetSecretContentResponse
-	(*SyncEnvironmentRequest)(nil),       // 4: alphomega.github.SyncEnvironmentRequest
-	(*SyncEnvironmentResponse)(nil),      // 5: alphomega.github.SyncEnvironmentResponse
-	(*DeleteSecretRequest)(nil),          // 6: alphomega.github.DeleteSecretRequest
-	(*DeleteSecretResponse)(nil),         // 7: alphomega.github.DeleteSecretResponse
-	(*CreateSecretRequest)(nil),          // 8: alphomega.github.CreateSecretRequest
-	(*CreateSecretResponse)(nil),         // 9: alphomega.github.CreateSecretResponse
-	(*GetSecretsRequest)(nil),            // 10: alphomega.github.GetSecretsRequest
-	(*GetSecretsResponse)(nil),           // 11: alphomega.github.GetSecretsResponse
-	(*Secret)(nil),                       // 12: alphomega.github.Secret
-	(*Package)(nil),                      // 13: alphomega.github.Package
-	(*SimplePackage)(nil),                // 14: alphomega.github.SimplePackage
-	(*GitPackage)(nil),                   // 15: alphomega.github.GitPackage
-	(*File)(nil),                         // 16: alphomega.github.File
-	(*PackageIdentifier)(nil),            // 17: alphomega.github.PackageIdentifier
-	(*GetPackageTagsRequest)(nil),        // 18: alphomega.github.GetPackageTagsRequest
-	(*GetPackageTagsResponse)(nil),       // 19: alphomega.github.GetPackageTagsResponse
-	(*PackageTag)(nil),                   // 20: alphomega.github.PackageTag
-	(*DeletePackageVersionRequest)(nil),  // 21: alphomega.github.DeletePackageVersionRequest
-	(*DeletePackageVersionResponse)(nil), // 22: alphomega.github.DeletePackageVersionResponse
-	(*CreatePackageVersionRequest)(nil),  // 23: alphomega.github.CreatePackageVersionRequest
-	(*CreatePackageVersionResponse)(nil), // 24: alphomega.github.CreatePackageVersionResponse
-	(*GetPackageFileRequest)(nil),        // 25: alphomega.github.GetPackageFileRequest
-	(*GetPackageFileResponse)(nil),       // 26: alphomega.github.GetPackageFileResponse
-	(*GetPackageResponse)(nil),           // 27: alphomega.github.GetPackageResponse
-	(*PackageVersion)(nil),               // 28: alphomega.github.PackageVersion
-	(*ContainerPackageRequest)(nil),      // 29: alphomega.github.ContainerPackageRequest
-	(*ContainerPackageResponse)(nil),     // 30: alphomega.github.ContainerPackageResponse
-	(*PushPackageRequest)(nil),           // 31: alphomega.github.PushPackageRequest
-	(*PushPackageResponse)(nil),          // 32: alphomega.github.PushPackageResponse
-	(*CreatePackageRequest)(nil),         // 33: alphomega.github.CreatePackageRequest
-	(*CreatePackageResponse)(nil),        // 34: alphomega.github.CreatePackageResponse
-	(*GetPackageRequest)(nil),            // 35: alphomega.github.GetPackageRequest
-	(*GetPackagesRequest)(nil),           // 36: alphomega.github.GetPackagesRequest
-	(*GetPackagesResponse)(nil),          // 37: alphomega.github.GetPackagesResponse
-	(*docker.Container)(nil),             // 38: alphomega.docker.Container
-}
-var file_proto_github_github_proto_depIdxs = []int32{
-	12, // 0: alphomega.github.GetSecretsResponse.secrets:type_name -> alphomega.github.Secret
-	16, // 1: alphomega.github.Package.files:type_name -> alphomega.github.File
-	38, // 2: alphomega.github.Package.containers:type_name -> alphomega.docker.Container
-	28, // 3: alphomega.github.GetPackageResponse.versions:type_name -> alphomega.github.PackageVersion
-	14, // 4: alphomega.github.GetPackagesResponse.packages:type_name -> alphomega.github.SimplePackage
-	2,  // 5: alphomega.github.GithubService.GetSecretContent:input_type -> alphomega.github.GetSecretContentRequest
-	10, // 6: alphomega.github.GithubService.GetSecrets:input_type -> alphomega.github.GetSecretsRequest
-	8,  // 7: alphomega.github.GithubService.CreateSecret:input_type -> alphomega.github.CreateSecretRequest
-	6,  // 8: alphomega.github.GithubService.DeleteSecret:input_type -> alphomega.github.DeleteSecretRequest
-	4,  // 9: alphomega.github.GithubService.SyncEnvironment:input_type -> alphomega.github.SyncEnvironmentRequest
-	31, // 10: alphomega.github.GithubService.PushPackage:input_type -> alphomega.github.PushPackageRequest
-	29, // 11: alphomega.github.GithubService.ContainerPackage:input_type -> alphomega.github.ContainerPackageRequest
-	36, // 12: alphomega.github.GithubService.GetPackages:input_type -> alphomega.github.GetPackagesRequest
-	35, // 13: alphomega.github.GithubService.GetPackage:input_type -> alphomega.github.GetPackageRequest
-	18, // 14: alphomega.github.GithubService.GetPackageTags:input_type -> alphomega.github.GetPackageTagsRequest
-	25, // 15: alphomega.github.GithubService.GetPackageFile:input_type -> alphomega.github.GetPackageFileRequest
-	33, // 16: alphomega.github.GithubService.CreatePackage:input_type -> alphomega.github.CreatePackageRequest
-	0,  // 17: alphomega.github.GithubService.DeletePackage:input_type -> alphomega.github.DeletePackageRequest
-	23, // 18: alphomega.github.GithubService.CreatePackageVersion:input_type -> alphomega.github.CreatePackageVersionRequest
-	21, // 19: alphomega.github.GithubService.DeletePackageVersion:input_type -> alphomega.github.DeletePackageVersionRequest
-	3,  // 20: alphomega.github.GithubService.GetSecretContent:output_type -> alphomega.github.GetSecretContentResponse
-	11, // 21: alphomega.github.GithubService.GetSecrets:output_type -> alphomega.github.GetSecretsResponse
-	9,  // 22: alphomega.github.GithubService.CreateSecret:output_type -> alphomega.github.CreateSecretResponse
-	7,  // 23: alphomega.github.GithubService.DeleteSecret:output_type -> alphomega.github.DeleteSecretResponse
-	5,  // 24: alphomega.github.GithubService.SyncEnvironment:output_type -> alphomega.github.SyncEnvironmentResponse
-	32, // 25: alphomega.github.GithubService.PushPackage:output_type -> alphomega.github.PushPackageResponse
-	30, // 26: alphomega.github.GithubService.ContainerPackage:output_type -> alphomega.github.ContainerPackageResponse
-	37, // 27: alphomega.github.GithubService.GetPackages:output_type -> alphomega.github.GetPackagesResponse
-	27, // 28: alphomega.github.GithubService.GetPackage:output_type -> alphomega.github.GetPackageResponse
-	19, // 29: alphomega.github.GithubService.GetPackageTags:output_type -> alphomega.github.GetPackageTagsResponse
-	26, // 30: alphomega.github.GithubService.GetPackageFile:output_type -> alphomega.github.GetPackageFileResponse
-	34, // 31: alphomega.github.GithubService.CreatePackage:output_type -> alphomega.github.CreatePackageResponse
-	1,  // 32: alphomega.github.GithubService.DeletePackage:output_type -> alphomega.github.DeletePackageResponse
-	24, // 33: alphomega.github.GithubService.CreatePackageVersion:output_type -> alphomega.github.CreatePackageVersionResponse
-	22, // 34: alphomega.github.GithubService.DeletePackageVersion:output_type -> alphomega.github.DeletePackageVersionResponse
-	20, // [20:35] is the sub-list for method output_type
-	5,  // [5:20] is the sub-list for method input_type
-	5,  // [5:5] is the sub-list for extension type_name
-	5,  // [5:5] is the sub-list for extension extendee
-	0,  // [0:5] is the sub-list for field type_name
-}
-
-func init() { file_proto_github_github_proto_init() }
-func file_proto_github_github_proto_init() {
-	if File_proto_github_github_proto != nil {
-		return
-	}
-	if !protoimpl.UnsafeEnabled {
-		file_proto_github_github_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeletePackageRequest); i {
+		file_proto_github_github_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetSecretRepositoriesResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2516,8 +4639,8 @@ func file_proto_github_github_proto_init() {
 				return nil
 			}
 		}
-		file_proto_github_github_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeletePackageResponse); i {
+		file_proto_github_github_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddSecretRepositoryRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2528,8 +4651,8 @@ func file_proto_github_github_proto_init() {
 				return nil
 			}
 		}
-		file_proto_github_github_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetSecretContentRequest); i {
+		file_proto_github_github_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddSecretRepositoryResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2540,8 +4663,8 @@ func file_proto_github_github_proto_init() {
 				return nil
 			}
 		}
-		file_proto_github_github_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetSecretContentResponse); i {
+		file_proto_github_github_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemoveSecretRepositoryRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2552,8 +4675,8 @@ func file_proto_github_github_proto_init() {
 				return nil
 			}
 		}
-		file_proto_github_github_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SyncEnvironmentRequest); i {
+		file_proto_github_github_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemoveSecretRepositoryResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2564,8 +4687,8 @@ func file_proto_github_github_proto_init() {
 				return nil
 			}
 		}
-		file_proto_github_github_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SyncEnvironmentResponse); i {
+		file_proto_github_github_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Variable); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2576,8 +4699,8 @@ func file_proto_github_github_proto_init() {
 				return nil
 			}
 		}
-		file_proto_github_github_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteSecretRequest); i {
+		file_proto_github_github_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetVariablesRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2588,8 +4711,8 @@ func file_proto_github_github_proto_init() {
 				return nil
 			}
 		}
-		file_proto_github_github_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteSecretResponse); i {
+		file_proto_github_github_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetVariablesResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2600,8 +4723,8 @@ func file_proto_github_github_proto_init() {
 				return nil
 			}
 		}
-		file_proto_github_github_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateSecretRequest); i {
+		file_proto_github_github_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetVariableRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2612,8 +4735,8 @@ func file_proto_github_github_proto_init() {
 				return nil
 			}
 		}
-		file_proto_github_github_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateSecretResponse); i {
+		file_proto_github_github_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetVariableResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2624,8 +4747,8 @@ func file_proto_github_github_proto_init() {
 				return nil
 			}
 		}
-		file_proto_github_github_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetSecretsRequest); i {
+		file_proto_github_github_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateVariableRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2636,8 +4759,8 @@ func file_proto_github_github_proto_init() {
 				return nil
 			}
 		}
-		file_proto_github_github_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetSecretsResponse); i {
+		file_proto_github_github_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateVariableResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2648,8 +4771,8 @@ func file_proto_github_github_proto_init() {
 				return nil
 			}
 		}
-		file_proto_github_github_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Secret); i {
+		file_proto_github_github_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateVariableRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2660,7 +4783,43 @@ func file_proto_github_github_proto_init() {
 				return nil
 			}
 		}
-		file_proto_github_github_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+		file_proto_github_github_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateVariableResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_github_github_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteVariableRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_github_github_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteVariableResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_github_github_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Package); i {
 			case 0:
 				return &v.state
@@ -2672,7 +4831,7 @@ func file_proto_github_github_proto_init() {
 				return nil
 			}
 		}
-		file_proto_github_github_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+		file_proto_github_github_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SimplePackage); i {
 			case 0:
 				return &v.state
@@ -2684,7 +4843,7 @@ func file_proto_github_github_proto_init() {
 				return nil
 			}
 		}
-		file_proto_github_github_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+		file_proto_github_github_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GitPackage); i {
 			case 0:
 				return &v.state
@@ -2696,7 +4855,7 @@ func file_proto_github_github_proto_init() {
 				return nil
 			}
 		}
-		file_proto_github_github_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+		file_proto_github_github_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*File); i {
 			case 0:
 				return &v.state
@@ -2708,7 +4867,7 @@ func file_proto_github_github_proto_init() {
 				return nil
 			}
 		}
-		file_proto_github_github_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+		file_proto_github_github_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*PackageIdentifier); i {
 			case 0:
 				return &v.state
@@ -2720,7 +4879,7 @@ func file_proto_github_github_proto_init() {
 				return nil
 			}
 		}
-		file_proto_github_github_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+		file_proto_github_github_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetPackageTagsRequest); i {
 			case 0:
 				return &v.state
@@ -2732,7 +4891,7 @@ func file_proto_github_github_proto_init() {
 				return nil
 			}
 		}
-		file_proto_github_github_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+		file_proto_github_github_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetPackageTagsResponse); i {
 			case 0:
 				return &v.state
@@ -2744,7 +4903,7 @@ func file_proto_github_github_proto_init() {
 				return nil
 			}
 		}
-		file_proto_github_github_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+		file_proto_github_github_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*PackageTag); i {
 			case 0:
 				return &v.state
@@ -2756,7 +4915,7 @@ func file_proto_github_github_proto_init() {
 				return nil
 			}
 		}
-		file_proto_github_github_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+		file_proto_github_github_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*DeletePackageVersionRequest); i {
 			case 0:
 				return &v.state
@@ -2768,7 +4927,7 @@ func file_proto_github_github_proto_init() {
 				return nil
 			}
 		}
-		file_proto_github_github_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+		file_proto_github_github_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*DeletePackageVersionResponse); i {
 			case 0:
 				return &v.state
@@ -2780,7 +4939,7 @@ func file_proto_github_github_proto_init() {
 				return nil
 			}
 		}
-		file_proto_github_github_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+		file_proto_github_github_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*CreatePackageVersionRequest); i {
 			case 0:
 				return &v.state
@@ -2792,7 +4951,7 @@ func file_proto_github_github_proto_init() {
 				return nil
 			}
 		}
-		file_proto_github_github_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+		file_proto_github_github_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*CreatePackageVersionResponse); i {
 			case 0:
 				return &v.state
@@ -2804,7 +4963,7 @@ func file_proto_github_github_proto_init() {
 				return nil
 			}
 		}
-		file_proto_github_github_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+		file_proto_github_github_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetPackageFileRequest); i {
 			case 0:
 				return &v.state
@@ -2816,7 +4975,7 @@ func file_proto_github_github_proto_init() {
 				return nil
 			}
 		}
-		file_proto_github_github_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+		file_proto_github_github_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetPackageFileResponse); i {
 			case 0:
 				return &v.state
@@ -2828,7 +4987,7 @@ func file_proto_github_github_proto_init() {
 				return nil
 			}
 		}
-		file_proto_github_github_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+		file_proto_github_github_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetPackageResponse); i {
 			case 0:
 				return &v.state
@@ -2840,7 +4999,7 @@ func file_proto_github_github_proto_init() {
 				return nil
 			}
 		}
-		file_proto_github_github_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+		file_proto_github_github_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*PackageVersion); i {
 			case 0:
 				return &v.state
@@ -2852,7 +5011,7 @@ func file_proto_github_github_proto_init() {
 				return nil
 			}
 		}
-		file_proto_github_github_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+		file_proto_github_github_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ContainerPackageRequest); i {
 			case 0:
 				return &v.state
@@ -2864,7 +5023,7 @@ func file_proto_github_github_proto_init() {
 				return nil
 			}
 		}
-		file_proto_github_github_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+		file_proto_github_github_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ContainerPackageResponse); i {
 			case 0:
 				return &v.state
@@ -2876,7 +5035,7 @@ func file_proto_github_github_proto_init() {
 				return nil
 			}
 		}
-		file_proto_github_github_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+		file_proto_github_github_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*PushPackageRequest); i {
 			case 0:
 				return &v.state
@@ -2888,7 +5047,7 @@ func file_proto_github_github_proto_init() {
 				return nil
 			}
 		}
-		file_proto_github_github_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+		file_proto_github_github_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*PushPackageResponse); i {
 			case 0:
 				return &v.state
@@ -2900,7 +5059,7 @@ func file_proto_github_github_proto_init() {
 				return nil
 			}
 		}
-		file_proto_github_github_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+		file_proto_github_github_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*CreatePackageRequest); i {
 			case 0:
 				return &v.state
@@ -2912,7 +5071,7 @@ func file_proto_github_github_proto_init() {
 				return nil
 			}
 		}
-		file_proto_github_github_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+		file_proto_github_github_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*CreatePackageResponse); i {
 			case 0:
 				return &v.state
@@ -2924,7 +5083,7 @@ func file_proto_github_github_proto_init() {
 				return nil
 			}
 		}
-		file_proto_github_github_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+		file_proto_github_github_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetPackageRequest); i {
 			case 0:
 				return &v.state
@@ -2936,7 +5095,7 @@ func file_proto_github_github_proto_init() {
 				return nil
 			}
 		}
-		file_proto_github_github_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+		file_proto_github_github_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetPackagesRequest); i {
 			case 0:
 				return &v.state
@@ -2948,7 +5107,7 @@ func file_proto_github_github_proto_init() {
 				return nil
 			}
 		}
-		file_proto_github_github_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+		file_proto_github_github_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetPackagesResponse); i {
 			case 0:
 				return &v.state
@@ -2961,20 +5120,21 @@ func file_proto_github_github_proto_init() {
 			}
 		}
 	}
-	file_proto_github_github_proto_msgTypes[21].OneofWrappers = []interface{}{}
-	file_proto_github_github_proto_msgTypes[28].OneofWrappers = []interface{}{}
+	file_proto_github_github_proto_msgTypes[44].OneofWrappers = []interface{}{}
+	file_proto_github_github_proto_msgTypes[51].OneofWrappers = []interface{}{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_proto_github_github_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   38,
+			NumEnums:      2,
+			NumMessages:   61,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_proto_github_github_proto_goTypes,
 		DependencyIndexes: file_proto_github_github_proto_depIdxs,
+		EnumInfos:         file_proto_github_github_proto_enumTypes,
 		MessageInfos:      file_proto_github_github_proto_msgTypes,
 	}.Build()
 	File_proto_github_github_proto = out.File
@@ -26,6 +26,16 @@ type GithubServiceClient interface {
 	GetSecrets(ctx context.Context, in *GetSecretsRequest, opts ...grpc.CallOption) (*GetSecretsResponse, error)
 	CreateSecret(ctx context.Context, in *CreateSecretRequest, opts ...grpc.CallOption) (*CreateSecretResponse, error)
 	DeleteSecret(ctx context.Context, in *DeleteSecretRequest, opts ...grpc.CallOption) (*DeleteSecretResponse, error)
+	GetSecretHistory(ctx context.Context, in *GetSecretHistoryRequest, opts ...grpc.CallOption) (*GetSecretHistoryResponse, error)
+	RollbackSecret(ctx context.Context, in *RollbackSecretRequest, opts ...grpc.CallOption) (*RollbackSecretResponse, error)
+	GetSecretRepositories(ctx context.Context, in *GetSecretRepositoriesRequest, opts ...grpc.CallOption) (*GetSecretRepositoriesResponse, error)
+	AddSecretRepository(ctx context.Context, in *AddSecretRepositoryRequest, opts ...grpc.CallOption) (*AddSecretRepositoryResponse, error)
+	RemoveSecretRepository(ctx context.Context, in *RemoveSecretRepositoryRequest, opts ...grpc.CallOption) (*RemoveSecretRepositoryResponse, error)
+	GetVariables(ctx context.Context, in *GetVariablesRequest, opts ...grpc.CallOption) (*GetVariablesResponse, error)
+	GetVariable(ctx context.Context, in *GetVariableRequest, opts ...grpc.CallOption) (*GetVariableResponse, error)
+	CreateVariable(ctx context.Context, in *CreateVariableRequest, opts ...grpc.CallOption) (*CreateVariableResponse, error)
+	UpdateVariable(ctx context.Context, in *UpdateVariableRequest, opts ...grpc.CallOption) (*UpdateVariableResponse, error)
+	DeleteVariable(ctx context.Context, in *DeleteVariableRequest, opts ...grpc.CallOption) (*DeleteVariableResponse, error)
 	SyncEnvironment(ctx context.Context, in *SyncEnvironmentRequest, opts ...grpc.CallOption) (*SyncEnvironmentResponse, error)
 	PushPackage(ctx context.Context, in *PushPackageRequest, opts ...grpc.CallOption) (*PushPackageResponse, error)
 	ContainerPackage(ctx context.Context, in *ContainerPackageRequest, opts ...grpc.CallOption) (*ContainerPackageResponse, error)
@@ -83,6 +93,96 @@ func (c *githubServiceClient) DeleteSecret(ctx context.Context, in *DeleteSecret
 	return out, nil
 }
 
+func (c *githubServiceClient) GetSecretHistory(ctx context.Context, in *GetSecretHistoryRequest, opts ...grpc.CallOption) (*GetSecretHistoryResponse, error) {
+	out := new(GetSecretHistoryResponse)
+	err := c.cc.Invoke(ctx, "/alphomega.github.GithubService/GetSecretHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *githubServiceClient) RollbackSecret(ctx context.Context, in *RollbackSecretRequest, opts ...grpc.CallOption) (*RollbackSecretResponse, error) {
+	out := new(RollbackSecretResponse)
+	err := c.cc.Invoke(ctx, "/alphomega.github.GithubService/RollbackSecret", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *githubServiceClient) GetSecretRepositories(ctx context.Context, in *GetSecretRepositoriesRequest, opts ...grpc.CallOption) (*GetSecretRepositoriesResponse, error) {
+	out := new(GetSecretRepositoriesResponse)
+	err := c.cc.Invoke(ctx, "/alphomega.github.GithubService/GetSecretRepositories", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *githubServiceClient) AddSecretRepository(ctx context.Context, in *AddSecretRepositoryRequest, opts ...grpc.CallOption) (*AddSecretRepositoryResponse, error) {
+	out := new(AddSecretRepositoryResponse)
+	err := c.cc.Invoke(ctx, "/alphomega.github.GithubService/AddSecretRepository", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *githubServiceClient) RemoveSecretRepository(ctx context.Context, in *RemoveSecretRepositoryRequest, opts ...grpc.CallOption) (*RemoveSecretRepositoryResponse, error) {
+	out := new(RemoveSecretRepositoryResponse)
+	err := c.cc.Invoke(ctx, "/alphomega.github.GithubService/RemoveSecretRepository", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *githubServiceClient) GetVariables(ctx context.Context, in *GetVariablesRequest, opts ...grpc.CallOption) (*GetVariablesResponse, error) {
+	out := new(GetVariablesResponse)
+	err := c.cc.Invoke(ctx, "/alphomega.github.GithubService/GetVariables", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *githubServiceClient) GetVariable(ctx context.Context, in *GetVariableRequest, opts ...grpc.CallOption) (*GetVariableResponse, error) {
+	out := new(GetVariableResponse)
+	err := c.cc.Invoke(ctx, "/alphomega.github.GithubService/GetVariable", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *githubServiceClient) CreateVariable(ctx context.Context, in *CreateVariableRequest, opts ...grpc.CallOption) (*CreateVariableResponse, error) {
+	out := new(CreateVariableResponse)
+	err := c.cc.Invoke(ctx, "/alphomega.github.GithubService/CreateVariable", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *githubServiceClient) UpdateVariable(ctx context.Context, in *UpdateVariableRequest, opts ...grpc.CallOption) (*UpdateVariableResponse, error) {
+	out := new(UpdateVariableResponse)
+	err := c.cc.Invoke(ctx, "/alphomega.github.GithubService/UpdateVariable", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *githubServiceClient) DeleteVariable(ctx context.Context, in *DeleteVariableRequest, opts ...grpc.CallOption) (*DeleteVariableResponse, error) {
+	out := new(DeleteVariableResponse)
+	err := c.cc.Invoke(ctx, "/alphomega.github.GithubService/DeleteVariable", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *githubServiceClient) SyncEnvironment(ctx context.Context, in *SyncEnvironmentRequest, opts ...grpc.CallOption) (*SyncEnvironmentResponse, error) {
 	out := new(SyncEnvironmentResponse)
 	err := c.cc.Invoke(ctx, "/alphomega.github.GithubService/SyncEnvironment", in, out, opts...)
@@ -190,6 +290,16 @@ type GithubServiceServer interface {
 	GetSecrets(context.Context, *GetSecretsRequest) (*GetSecretsResponse, error)
 	CreateSecret(context.Context, *CreateSecretRequest) (*CreateSecretResponse, error)
 	DeleteSecret(context.Context, *DeleteSecretRequest) (*DeleteSecretResponse, error)
+	GetSecretHistory(context.Context, *GetSecretHistoryRequest) (*GetSecretHistoryResponse, error)
+	RollbackSecret(context.Context, *RollbackSecretRequest) (*RollbackSecretResponse, error)
+	GetSecretRepositories(context.Context, *GetSecretRepositoriesRequest) (*GetSecretRepositoriesResponse, error)
+	AddSecretRepository(context.Context, *AddSecretRepositoryRequest) (*AddSecretRepositoryResponse, error)
+	RemoveSecretRepository(context.Context, *RemoveSecretRepositoryRequest) (*RemoveSecretRepositoryResponse, error)
+	GetVariables(context.Context, *GetVariablesRequest) (*GetVariablesResponse, error)
+	GetVariable(context.Context, *GetVariableRequest) (*GetVariableResponse, error)
+	CreateVariable(context.Context, *CreateVariableRequest) (*CreateVariableResponse, error)
+	UpdateVariable(context.Context, *UpdateVariableRequest) (*UpdateVariableResponse, error)
+	DeleteVariable(context.Context, *DeleteVariableRequest) (*DeleteVariableResponse, error)
 	SyncEnvironment(context.Context, *SyncEnvironmentRequest) (*SyncEnvironmentResponse, error)
 	PushPackage(context.Context, *PushPackageRequest) (*PushPackageResponse, error)
 	ContainerPackage(context.Context, *ContainerPackageRequest) (*ContainerPackageResponse, error)
@@ -220,6 +330,36 @@ func (UnimplementedGithubServiceServer) CreateSecret(context.Context, *CreateSec
 func (UnimplementedGithubServiceServer) DeleteSecret(context.Context, *DeleteSecretRequest) (*DeleteSecretResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteSecret not implemented")
 }
+func (UnimplementedGithubServiceServer) GetSecretHistory(context.Context, *GetSecretHistoryRequest) (*GetSecretHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSecretHistory not implemented")
+}
+func (UnimplementedGithubServiceServer) RollbackSecret(context.Context, *RollbackSecretRequest) (*RollbackSecretResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RollbackSecret not implemented")
+}
+func (UnimplementedGithubServiceServer) GetSecretRepositories(context.Context, *GetSecretRepositoriesRequest) (*GetSecretRepositoriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSecretRepositories not implemented")
+}
+func (UnimplementedGithubServiceServer) AddSecretRepository(context.Context, *AddSecretRepositoryRequest) (*AddSecretRepositoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddSecretRepository not implemented")
+}
+func (UnimplementedGithubServiceServer) RemoveSecretRepository(context.Context, *RemoveSecretRepositoryRequest) (*RemoveSecretRepositoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveSecretRepository not implemented")
+}
+func (UnimplementedGithubServiceServer) GetVariables(context.Context, *GetVariablesRequest) (*GetVariablesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetVariables not implemented")
+}
+func (UnimplementedGithubServiceServer) GetVariable(context.Context, *GetVariableRequest) (*GetVariableResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetVariable not implemented")
+}
+func (UnimplementedGithubServiceServer) CreateVariable(context.Context, *CreateVariableRequest) (*CreateVariableResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateVariable not implemented")
+}
+func (UnimplementedGithubServiceServer) UpdateVariable(context.Context, *UpdateVariableRequest) (*UpdateVariableResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateVariable not implemented")
+}
+func (UnimplementedGithubServiceServer) DeleteVariable(context.Context, *DeleteVariableRequest) (*DeleteVariableResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteVariable not implemented")
+}
 func (UnimplementedGithubServiceServer) SyncEnvironment(context.Context, *SyncEnvironmentRequest) (*SyncEnvironmentResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SyncEnvironment not implemented")
 }
@@ -338,6 +478,186 @@ func _GithubService_DeleteSecret_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _GithubService_GetSecretHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSecretHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GithubServiceServer).GetSecretHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/alphomega.github.GithubService/GetSecretHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GithubServiceServer).GetSecretHistory(ctx, req.(*GetSecretHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GithubService_RollbackSecret_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RollbackSecretRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GithubServiceServer).RollbackSecret(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/alphomega.github.GithubService/RollbackSecret",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GithubServiceServer).RollbackSecret(ctx, req.(*RollbackSecretRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GithubService_GetSecretRepositories_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSecretRepositoriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GithubServiceServer).GetSecretRepositories(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/alphomega.github.GithubService/GetSecretRepositories",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GithubServiceServer).GetSecretRepositories(ctx, req.(*GetSecretRepositoriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GithubService_AddSecretRepository_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddSecretRepositoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GithubServiceServer).AddSecretRepository(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/alphomega.github.GithubService/AddSecretRepository",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GithubServiceServer).AddSecretRepository(ctx, req.(*AddSecretRepositoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GithubService_RemoveSecretRepository_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveSecretRepositoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GithubServiceServer).RemoveSecretRepository(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/alphomega.github.GithubService/RemoveSecretRepository",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GithubServiceServer).RemoveSecretRepository(ctx, req.(*RemoveSecretRepositoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GithubService_GetVariables_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetVariablesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GithubServiceServer).GetVariables(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/alphomega.github.GithubService/GetVariables",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GithubServiceServer).GetVariables(ctx, req.(*GetVariablesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GithubService_GetVariable_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetVariableRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GithubServiceServer).GetVariable(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/alphomega.github.GithubService/GetVariable",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GithubServiceServer).GetVariable(ctx, req.(*GetVariableRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GithubService_CreateVariable_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateVariableRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GithubServiceServer).CreateVariable(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/alphomega.github.GithubService/CreateVariable",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GithubServiceServer).CreateVariable(ctx, req.(*CreateVariableRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GithubService_UpdateVariable_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateVariableRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GithubServiceServer).UpdateVariable(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/alphomega.github.GithubService/UpdateVariable",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GithubServiceServer).UpdateVariable(ctx, req.(*UpdateVariableRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GithubService_DeleteVariable_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteVariableRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GithubServiceServer).DeleteVariable(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/alphomega.github.GithubService/DeleteVariable",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GithubServiceServer).DeleteVariable(ctx, req.(*DeleteVariableRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _GithubService_SyncEnvironment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(SyncEnvironmentRequest)
 	if err := dec(in); err != nil {
@@ -559,6 +879,46 @@ var GithubService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteSecret",
 			Handler:    _GithubService_DeleteSecret_Handler,
 		},
+		{
+			MethodName: "GetSecretHistory",
+			Handler:    _GithubService_GetSecretHistory_Handler,
+		},
+		{
+			MethodName: "RollbackSecret",
+			Handler:    _GithubService_RollbackSecret_Handler,
+		},
+		{
+			MethodName: "GetSecretRepositories",
+			Handler:    _GithubService_GetSecretRepositories_Handler,
+		},
+		{
+			MethodName: "AddSecretRepository",
+			Handler:    _GithubService_AddSecretRepository_Handler,
+		},
+		{
+			MethodName: "RemoveSecretRepository",
+			Handler:    _GithubService_RemoveSecretRepository_Handler,
+		},
+		{
+			MethodName: "GetVariables",
+			Handler:    _GithubService_GetVariables_Handler,
+		},
+		{
+			MethodName: "GetVariable",
+			Handler:    _GithubService_GetVariable_Handler,
+		},
+		{
+			MethodName: "CreateVariable",
+			Handler:    _GithubService_CreateVariable_Handler,
+		},
+		{
+			MethodName: "UpdateVariable",
+			Handler:    _GithubService_UpdateVariable_Handler,
+		},
+		{
+			MethodName: "DeleteVariable",
+			Handler:    _GithubService_DeleteVariable_Handler,
+		},
 		{
 			MethodName: "SyncEnvironment",
 			Handler:    _GithubService_SyncEnvironment_Handler,